@@ -2,15 +2,17 @@ package gateway
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/odysseia-greek/agora/hesiodos"
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/randomizer"
+	aristophanes "github.com/odysseia-greek/attike/aristophanes/comedy"
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
 	"github.com/odysseia-greek/makedonia/antigonos/monophthalmus"
 	"github.com/odysseia-greek/makedonia/eukleides/geometrias"
-	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 	"github.com/odysseia-greek/makedonia/hefaistion/philia"
 	"github.com/odysseia-greek/makedonia/parmenion/strategos"
 	"github.com/odysseia-greek/makedonia/perdikkas/epimeleia"
@@ -19,15 +21,27 @@ import (
 )
 
 type AlexandrosHandler struct {
-	Streamer        arv1.TraceService_ChorusClient
-	CounterStreamer pbe.Eukleides_CreateNewEntryClient
-	Counter         *geometrias.CounterClient
-	Randomizer      randomizer.Random
-	FuzzyClient     *hesiodos.GenericGrpcClient[*monophthalmus.FuzzyClient]
-	ExactClient     *hesiodos.GenericGrpcClient[*philia.ExactClient]
-	ExtendedClient  *hesiodos.GenericGrpcClient[*aigyptos.ExtendedClient]
-	PhraseClient    *hesiodos.GenericGrpcClient[*strategos.PhraseClient]
-	PartialClient   *hesiodos.GenericGrpcClient[*epimeleia.PartialClient]
+	Tracer         *aristophanes.ClientTracer
+	Streamer       arv1.TraceService_ChorusClient
+	Ingester       *geometrias.Ingester
+	Counter        *geometrias.CounterClient
+	Randomizer     randomizer.Random
+	FuzzyClient    *hesiodos.GenericGrpcClient[*monophthalmus.FuzzyClient]
+	ExactClient    *hesiodos.GenericGrpcClient[*philia.ExactClient]
+	ExtendedClient *hesiodos.GenericGrpcClient[*aigyptos.ExtendedClient]
+	PhraseClient   *hesiodos.GenericGrpcClient[*strategos.PhraseClient]
+	PartialClient  *hesiodos.GenericGrpcClient[*epimeleia.PartialClient]
+	// ExpandDeadlines falls back to DefaultExpandDeadlines when left empty
+	ExpandDeadlines ExpandDeadlines
+	// HealthDeadline falls back to DefaultHealthDeadline when left empty
+	HealthDeadline time.Duration
+	// Cache is nil unless a cache backend is configured
+	Cache *ResponseCache
+	// SearchEvents and DictionaryEvents feed the subscriptions
+	SearchEvents     *Broker[SearchEvent]
+	DictionaryEvents *Broker[*model.DictionaryEvent]
+	// draining is set once a shutdown started, /readyz fails from then on
+	draining atomic.Bool
 }
 
 func (a *AlexandrosHandler) outgoingCtx(parent context.Context) (context.Context, context.CancelFunc, string) {
@@ -0,0 +1,332 @@
+package gateway
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/odysseia-greek/agora/archytas"
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	CacheMemory string = "memory"
+	CacheBadger string = "badger"
+)
+
+// CacheConfig enables the response cache when Backend is set. Badger keeps entries on disk at Path, or in memory
+// when Path is empty.
+type CacheConfig struct {
+	Backend    string
+	Path       string
+	TTL        time.Duration
+	MaxEntries int
+}
+
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		TTL:        time.Hour,
+		MaxEntries: 10000,
+	}
+}
+
+// CacheStats is what the cache reports in health.
+type CacheStats struct {
+	Backend   string
+	Entries   int
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Purges    int64
+}
+
+// cacheStore holds the cached bytes, the ResponseCache around it decides what is kept and for how long. Keys carry
+// the generation of the cache, so a store that cannot remove or clear leaves entries nobody looks up again until
+// their ttl runs out.
+type cacheStore interface {
+	get(key string) ([]byte, bool)
+	set(key string, value []byte, ttl time.Duration) error
+	remove(key string)
+	clear() error
+	close() error
+}
+
+type cacheEntry struct {
+	key     string
+	expires time.Time
+}
+
+// ResponseCache keeps search responses until they expire, the cache is full or demokritos reseeds the dictionary.
+// The least recently used entry makes way once MaxEntries is reached.
+type ResponseCache struct {
+	mu         sync.Mutex
+	backend    string
+	store      cacheStore
+	order      *list.List
+	index      map[string]*list.Element
+	ttl        time.Duration
+	maxEntries int
+	// generation goes up with every purge, a response fetched before one is not stored after it
+	generation uint64
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+	purges    atomic.Int64
+}
+
+func NewResponseCache(cfg CacheConfig) (*ResponseCache, error) {
+	if cfg.TTL <= 0 || cfg.MaxEntries <= 0 {
+		return nil, errors.New("cache ttl and max entries must be positive")
+	}
+
+	var store cacheStore
+	switch cfg.Backend {
+	case CacheMemory:
+		store = &memoryStore{values: make(map[string][]byte)}
+	case CacheBadger:
+		badgerStore, err := newBadgerStore(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		store = badgerStore
+	default:
+		return nil, fmt.Errorf("unsupported cache backend: %s", cfg.Backend)
+	}
+
+	return &ResponseCache{
+		backend:    cfg.Backend,
+		store:      store,
+		order:      list.New(),
+		index:      make(map[string]*list.Element),
+		ttl:        cfg.TTL,
+		maxEntries: cfg.MaxEntries,
+	}, nil
+}
+
+func (c *ResponseCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.index[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	if time.Now().After(element.Value.(*cacheEntry).expires) {
+		c.removeElement(element)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	value, ok := c.store.get(c.storeKey(key))
+	if !ok {
+		c.removeElement(element)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	c.hits.Add(1)
+	return value, true
+}
+
+func (c *ResponseCache) Set(key string, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.set(key, value)
+}
+
+// Generation is taken before fetching a response that is stored with SetAt.
+func (c *ResponseCache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+// SetAt stores value unless the cache was purged since generation, the response then describes the old dictionary.
+// It reports whether value was stored.
+func (c *ResponseCache) SetAt(generation uint64, key string, value []byte) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return false, nil
+	}
+	return true, c.set(key, value)
+}
+
+// set stores value under the current generation. Callers hold c.mu.
+func (c *ResponseCache) set(key string, value []byte) error {
+	if err := c.store.set(c.storeKey(key), value, c.ttl); err != nil {
+		return err
+	}
+
+	expires := time.Now().Add(c.ttl)
+	if element, ok := c.index[key]; ok {
+		element.Value.(*cacheEntry).expires = expires
+		c.order.MoveToFront(element)
+		return nil
+	}
+
+	c.index[key] = c.order.PushFront(&cacheEntry{key: key, expires: expires})
+	for c.order.Len() > c.maxEntries {
+		c.removeElement(c.order.Back())
+		c.evictions.Add(1)
+	}
+	return nil
+}
+
+// Purge drops every entry, it is called when the dictionary behind the responses changed.
+func (c *ResponseCache) Purge() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.index = make(map[string]*list.Element)
+	c.generation++
+	c.purges.Add(1)
+	return c.store.clear()
+}
+
+func (c *ResponseCache) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.order.Len()
+	c.mu.Unlock()
+
+	return CacheStats{
+		Backend:   c.backend,
+		Entries:   entries,
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Purges:    c.purges.Load(),
+	}
+}
+
+func (c *ResponseCache) Close() error {
+	return c.store.close()
+}
+
+// removeElement drops an entry from the index and the store. Callers hold c.mu.
+func (c *ResponseCache) removeElement(element *list.Element) {
+	entry := c.order.Remove(element).(*cacheEntry)
+	delete(c.index, entry.key)
+	c.store.remove(c.storeKey(entry.key))
+}
+
+// storeKey is key within the current generation. Callers hold c.mu.
+func (c *ResponseCache) storeKey(key string) string {
+	return fmt.Sprintf("%d|%s", c.generation, key)
+}
+
+// cacheKey identifies a search by strategy, language, page and the word in NFC with surrounding and repeated spaces
+// removed. Case and diacritics are kept, the exact strategy tells those apart.
+func cacheKey(strategy string, request *koinos.SearchQuery) string {
+	word := strings.Join(strings.Fields(norm.NFC.String(request.Word)), " ")
+	return fmt.Sprintf("%s|%s|%d|%d|%s|%s", strategy, request.Language, request.Page, request.NumberOfResults, request.SearchAfter, word)
+}
+
+// cached answers from the cache when one is set and the response is there, otherwise it calls fetch and stores a
+// successful response. empty is the message a cached response is decoded into.
+func cached[T proto.Message](c *ResponseCache, strategy string, request *koinos.SearchQuery, empty T, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	key := cacheKey(strategy, request)
+	if raw, ok := c.Get(key); ok {
+		if err := proto.Unmarshal(raw, empty); err == nil {
+			return empty, nil
+		}
+	}
+
+	// taken before the fetch, a reseed completing while it runs makes the response stale
+	generation := c.Generation()
+	response, err := fetch()
+	if err != nil {
+		return response, err
+	}
+
+	if raw, err := proto.Marshal(response); err == nil {
+		// a response that cannot be cached is still a good response
+		_, _ = c.SetAt(generation, key, raw)
+	}
+	return response, nil
+}
+
+type memoryStore struct {
+	values map[string][]byte
+}
+
+func (m *memoryStore) get(key string) ([]byte, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+func (m *memoryStore) set(key string, value []byte, _ time.Duration) error {
+	m.values[key] = value
+	return nil
+}
+
+func (m *memoryStore) remove(key string) {
+	delete(m.values, key)
+}
+
+func (m *memoryStore) clear() error {
+	m.values = make(map[string][]byte)
+	return nil
+}
+
+func (m *memoryStore) close() error {
+	return nil
+}
+
+// badgerStore keeps the entries in Badger through archytas. Archytas cannot delete, removed and purged entries stay
+// on disk until their ttl runs out, their keys belong to an older generation or were never indexed in this run.
+type badgerStore struct {
+	cache archytas.Client
+}
+
+func newBadgerStore(path string) (*badgerStore, error) {
+	var cache archytas.Client
+	var err error
+	if path == "" {
+		cache, err = archytas.NewInMemoryBadgerClient()
+	} else {
+		cache, err = archytas.NewBadgerClient(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open cache: %w", err)
+	}
+
+	return &badgerStore{cache: cache}, nil
+}
+
+func (b *badgerStore) get(key string) ([]byte, bool) {
+	value, err := b.cache.Read(key)
+	return value, err == nil
+}
+
+func (b *badgerStore) set(key string, value []byte, ttl time.Duration) error {
+	return b.cache.SetWithTTL(key, string(value), ttl)
+}
+
+func (b *badgerStore) remove(string) {}
+
+func (b *badgerStore) clear() error {
+	return nil
+}
+
+func (b *badgerStore) close() error {
+	return b.cache.Close()
+}
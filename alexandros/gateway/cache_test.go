@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	for _, backend := range []string{CacheMemory, CacheBadger} {
+		t.Run(backend, func(t *testing.T) {
+			cfg := DefaultCacheConfig()
+			cfg.Backend = backend
+			cfg.MaxEntries = 2
+
+			newCache := func(t *testing.T) *ResponseCache {
+				cache, err := NewResponseCache(cfg)
+				assert.Nil(t, err)
+				t.Cleanup(func() { cache.Close() })
+				return cache
+			}
+
+			t.Run("EvictsLeastRecentlyUsed", func(t *testing.T) {
+				cache := newCache(t)
+				assert.Nil(t, cache.Set("a", []byte("λόγος")))
+				assert.Nil(t, cache.Set("b", []byte("θεός")))
+				_, _ = cache.Get("a")
+				assert.Nil(t, cache.Set("c", []byte("πόλις")))
+
+				_, ok := cache.Get("b")
+				assert.False(t, ok)
+				value, ok := cache.Get("a")
+				assert.True(t, ok)
+				assert.Equal(t, []byte("λόγος"), value)
+				assert.Equal(t, int64(1), cache.Stats().Evictions)
+			})
+
+			t.Run("PurgeDropsEverything", func(t *testing.T) {
+				cache := newCache(t)
+				assert.Nil(t, cache.Set("a", []byte("λόγος")))
+				assert.Nil(t, cache.Purge())
+
+				_, ok := cache.Get("a")
+				assert.False(t, ok)
+				assert.Equal(t, 0, cache.Stats().Entries)
+
+				// the same key is fine again after a purge
+				assert.Nil(t, cache.Set("a", []byte("θεός")))
+				value, ok := cache.Get("a")
+				assert.True(t, ok)
+				assert.Equal(t, []byte("θεός"), value)
+			})
+
+			t.Run("SkipsResponsesFetchedBeforeAPurge", func(t *testing.T) {
+				cache := newCache(t)
+				request := &koinos.SearchQuery{Word: "λόγος"}
+
+				_, err := cached(cache, "exact", request, &koinos.SearchQuery{}, func() (*koinos.SearchQuery, error) {
+					// a reseed completes while the backend answers
+					assert.Nil(t, cache.Purge())
+					return &koinos.SearchQuery{Word: "stale"}, nil
+				})
+				assert.Nil(t, err)
+				_, ok := cache.Get(cacheKey("exact", request))
+				assert.False(t, ok)
+
+				stored, err := cache.SetAt(cache.Generation(), "a", []byte("λόγος"))
+				assert.Nil(t, err)
+				assert.True(t, stored)
+			})
+		})
+	}
+
+	t.Run("RejectsInvalidConfig", func(t *testing.T) {
+		_, err := NewResponseCache(CacheConfig{Backend: CacheMemory, TTL: time.Minute})
+		assert.NotNil(t, err)
+
+		_, err = NewResponseCache(CacheConfig{Backend: "redis", TTL: time.Minute, MaxEntries: 1})
+		assert.NotNil(t, err)
+	})
+}
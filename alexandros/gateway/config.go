@@ -3,16 +3,18 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/odysseia-greek/agora/eupalinos/stomion"
 	"github.com/odysseia-greek/agora/hesiodos"
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/logging"
 	aristophanes "github.com/odysseia-greek/attike/aristophanes/comedy"
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
 	"github.com/odysseia-greek/makedonia/antigonos/monophthalmus"
 	"github.com/odysseia-greek/makedonia/eukleides/geometrias"
-	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 	"github.com/odysseia-greek/makedonia/hefaistion/philia"
 	"github.com/odysseia-greek/makedonia/parmenion/strategos"
 	"github.com/odysseia-greek/makedonia/perdikkas/epimeleia"
@@ -29,7 +31,7 @@ func CreateNewConfig(ctx context.Context) (*AlexandrosHandler, error) {
 	var tracer *aristophanes.ClientTracer
 	var streamer arv1.TraceService_ChorusClient
 	var eukleides *geometrias.CounterClient
-	var eukleidesStreamer pbe.Eukleides_CreateNewEntryClient
+	var ingester *geometrias.Ingester
 
 	maxRetries := 10
 	retryDelay := 3 * time.Second
@@ -78,19 +80,14 @@ func CreateNewConfig(ctx context.Context) (*AlexandrosHandler, error) {
 		}
 	}
 
-	for i := 1; i <= maxRetries; i++ {
-		if eukleides == nil {
-			break
-		}
-		eukleidesStreamer, err = eukleides.CreateNewEntry(ctx)
-		if err == nil {
-			break
+	if eukleides != nil {
+		ingestConfig, err := ingestConfigFromEnv()
+		if err != nil {
+			return nil, err
 		}
 
-		logging.Error(fmt.Sprintf("failed to create eukleides streamer (attempt %d/%d): %s", i, maxRetries, err.Error()))
-		if i < maxRetries {
-			time.Sleep(retryDelay)
-		}
+		ingester = geometrias.NewIngester(eukleides, ingestConfig)
+		ingester.Start(ctx)
 	}
 
 	healthyEukleides := false
@@ -169,6 +166,31 @@ func CreateNewConfig(ctx context.Context) (*AlexandrosHandler, error) {
 		extendedClientHealthy = extendedClient.Client.WaitForHealthyState()
 	}
 
+	expandDeadlines, err := expandDeadlinesFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	healthDeadline, err := time.ParseDuration(config.StringFromEnv("HEALTH_CHECK_TIMEOUT", DefaultHealthDeadline.String()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid HEALTH_CHECK_TIMEOUT: %w", err)
+	}
+
+	cacheConfig, err := cacheConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	var cache *ResponseCache
+	cacheOverview := "disabled"
+	if cacheConfig.Backend != "" {
+		cache, err = NewResponseCache(cacheConfig)
+		if err != nil {
+			return nil, err
+		}
+		cacheOverview = fmt.Sprintf("%s (TTL: %s, Max Entries: %d)", cacheConfig.Backend, cacheConfig.TTL, cacheConfig.MaxEntries)
+	}
+
 	elapsed := time.Since(start)
 
 	logging.System(fmt.Sprintf(`Alexandros Configuration Overview:
@@ -180,6 +202,7 @@ func CreateNewConfig(ctx context.Context) (*AlexandrosHandler, error) {
 - Hefaistion Service:  %v (Address: %s)
 - Perdikkas Service:   %v (Address: %s)
 - Ptolemaios Service:  %v (Address: %s)
+- Response Cache:      %s
 `,
 		elapsed,
 		healthyTracer, aristophanes.DefaultAddress,
@@ -189,17 +212,133 @@ func CreateNewConfig(ctx context.Context) (*AlexandrosHandler, error) {
 		exactClientHealthy, exactClientAddress,
 		partialClientHealthy, partialClientAddress,
 		extendedClientHealthy, extendedClientAddress,
+		cacheOverview,
 	))
 
-	return &AlexandrosHandler{
-		Streamer:        streamer,
-		Randomizer:      randomizer,
-		FuzzyClient:     fuzzyClient,
-		ExactClient:     exactClient,
-		PhraseClient:    phraseClient,
-		ExtendedClient:  extendedClient,
-		PartialClient:   partialClient,
-		CounterStreamer: eukleidesStreamer,
-		Counter:         eukleides,
-	}, nil
+	handler := &AlexandrosHandler{
+		Tracer:           tracer,
+		Streamer:         streamer,
+		Randomizer:       randomizer,
+		FuzzyClient:      fuzzyClient,
+		ExactClient:      exactClient,
+		PhraseClient:     phraseClient,
+		ExtendedClient:   extendedClient,
+		PartialClient:    partialClient,
+		Ingester:         ingester,
+		Counter:          eukleides,
+		ExpandDeadlines:  expandDeadlines,
+		HealthDeadline:   healthDeadline,
+		SearchEvents:     NewBroker[SearchEvent](),
+		DictionaryEvents: NewBroker[*model.DictionaryEvent](),
+	}
+
+	if cache != nil {
+		handler.Cache = cache
+	}
+	watchReseeds(ctx, handler)
+
+	return handler, nil
+}
+
+// ingestConfigFromEnv overrides the default batching of counts sent to eukleides.
+func ingestConfigFromEnv() (geometrias.IngestConfig, error) {
+	ingestConfig := geometrias.DefaultIngestConfig()
+
+	batchSize, err := strconv.Atoi(config.StringFromEnv("EUKLEIDES_BATCH_SIZE", strconv.Itoa(ingestConfig.BatchSize)))
+	if err != nil {
+		return ingestConfig, fmt.Errorf("invalid EUKLEIDES_BATCH_SIZE: %w", err)
+	}
+
+	queueSize, err := strconv.Atoi(config.StringFromEnv("EUKLEIDES_QUEUE_SIZE", strconv.Itoa(ingestConfig.QueueSize)))
+	if err != nil {
+		return ingestConfig, fmt.Errorf("invalid EUKLEIDES_QUEUE_SIZE: %w", err)
+	}
+
+	flushInterval, err := time.ParseDuration(config.StringFromEnv("EUKLEIDES_FLUSH_INTERVAL", ingestConfig.FlushInterval.String()))
+	if err != nil {
+		return ingestConfig, fmt.Errorf("invalid EUKLEIDES_FLUSH_INTERVAL: %w", err)
+	}
+
+	ackTimeout, err := time.ParseDuration(config.StringFromEnv("EUKLEIDES_ACK_TIMEOUT", ingestConfig.AckTimeout.String()))
+	if err != nil {
+		return ingestConfig, fmt.Errorf("invalid EUKLEIDES_ACK_TIMEOUT: %w", err)
+	}
+
+	maxSpillBytes, err := strconv.ParseInt(config.StringFromEnv("EUKLEIDES_SPILL_MAX_BYTES", strconv.FormatInt(ingestConfig.MaxSpillBytes, 10)), 10, 64)
+	if err != nil {
+		return ingestConfig, fmt.Errorf("invalid EUKLEIDES_SPILL_MAX_BYTES: %w", err)
+	}
+
+	ingestConfig.BatchSize = batchSize
+	ingestConfig.QueueSize = queueSize
+	ingestConfig.FlushInterval = flushInterval
+	ingestConfig.AckTimeout = ackTimeout
+	// events that do not fit the queue are dropped unless a spill file is set
+	ingestConfig.SpillPath = config.StringFromEnv("EUKLEIDES_SPILL_PATH", "")
+	ingestConfig.MaxSpillBytes = maxSpillBytes
+
+	return ingestConfig, nil
+}
+
+// expandDeadlinesFromEnv overrides how long each call of an expanded exact search may take.
+func expandDeadlinesFromEnv() (ExpandDeadlines, error) {
+	deadlines := DefaultExpandDeadlines()
+
+	for env, deadline := range map[string]*time.Duration{
+		"EXPAND_EXACT_TIMEOUT":    &deadlines.Exact,
+		"EXPAND_FUZZY_TIMEOUT":    &deadlines.Fuzzy,
+		"EXPAND_EXTENDED_TIMEOUT": &deadlines.Extended,
+	} {
+		parsed, err := time.ParseDuration(config.StringFromEnv(env, deadline.String()))
+		if err != nil {
+			return deadlines, fmt.Errorf("invalid %s: %w", env, err)
+		}
+		*deadline = parsed
+	}
+
+	return deadlines, nil
+}
+
+// cacheConfigFromEnv reads the response cache settings, the cache stays off unless CACHE_BACKEND is memory or badger.
+func cacheConfigFromEnv() (CacheConfig, error) {
+	cacheConfig := DefaultCacheConfig()
+
+	ttl, err := time.ParseDuration(config.StringFromEnv("CACHE_TTL", cacheConfig.TTL.String()))
+	if err != nil {
+		return cacheConfig, fmt.Errorf("invalid CACHE_TTL: %w", err)
+	}
+
+	maxEntries, err := strconv.Atoi(config.StringFromEnv("CACHE_MAX_ENTRIES", strconv.Itoa(cacheConfig.MaxEntries)))
+	if err != nil {
+		return cacheConfig, fmt.Errorf("invalid CACHE_MAX_ENTRIES: %w", err)
+	}
+
+	cacheConfig.Backend = config.StringFromEnv("CACHE_BACKEND", "")
+	cacheConfig.Path = config.StringFromEnv("CACHE_PATH", "")
+	cacheConfig.TTL = ttl
+	cacheConfig.MaxEntries = maxEntries
+
+	return cacheConfig, nil
+}
+
+// watchReseeds follows the reseed channel of demokritos for the dictionary subscription and to purge the cache after a
+// reseed. Without eupalinos the cache still works, entries then live until their TTL runs out.
+func watchReseeds(ctx context.Context, handler *AlexandrosHandler) {
+	interval, err := time.ParseDuration(config.StringFromEnv("CACHE_RESEED_POLL_INTERVAL", "30s"))
+	if err != nil {
+		logging.Error(fmt.Sprintf("invalid CACHE_RESEED_POLL_INTERVAL, not watching for reseeds: %s", err.Error()))
+		return
+	}
+
+	eupalinosAddress := config.StringFromEnv(config.EnvEupalinosService, config.DefaultEupalinosService)
+	queue, err := stomion.NewEupalinosClient(eupalinosAddress)
+	if err != nil {
+		logging.Error(fmt.Sprintf("failed to create eupalinos client, not watching for reseeds: %s", err.Error()))
+		return
+	}
+
+	// a dequeued message is gone, so every replica reads a channel of its own, named in DEMOKRITOS_RESEED_CHANNELS of
+	// demokritos. Set it from the pod name when running more than one replica.
+	channel := config.StringFromEnv("DEMOKRITOS_RESEED_CHANNEL", "demokritos-reseeds")
+	go handler.WatchReseeds(ctx, queue, channel, interval)
 }
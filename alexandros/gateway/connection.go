@@ -0,0 +1,44 @@
+package gateway
+
+import (
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+)
+
+// lemmaSearch is what hefaistion, antigonos, perdikkas and parmenion all answer a SearchQuery with.
+type lemmaSearch interface {
+	GetResults() []*koinos.Lemma
+	GetPageInfo() *koinos.PageInfo
+}
+
+// lemmaConnection pairs every result with the cursor the service handed out for it. The cursors are passed through
+// untouched, they are the search_after the service needs to continue right behind that result.
+func lemmaConnection(grpcResponse lemmaSearch, resumed bool) *model.LemmaConnection {
+	lemmas := parseResults(grpcResponse.GetResults())
+	info := grpcResponse.GetPageInfo()
+
+	connection := &model.LemmaConnection{
+		Edges: make([]*model.LemmaEdge, 0, len(lemmas)),
+		PageInfo: &model.ConnectionPageInfo{
+			HasNextPage:     info.GetHasNextPage(),
+			HasPreviousPage: resumed || info.GetPage() > 1,
+		},
+		TotalCount: info.GetTotal(),
+	}
+
+	cursors := info.GetCursors()
+	for i, lemma := range lemmas {
+		edge := &model.LemmaEdge{Node: lemma}
+		if i < len(cursors) {
+			edge.Cursor = cursors[i]
+		}
+		connection.Edges = append(connection.Edges, edge)
+	}
+
+	if len(cursors) > 0 {
+		connection.PageInfo.StartCursor = &cursors[0]
+		connection.PageInfo.EndCursor = &cursors[len(cursors)-1]
+	}
+
+	return connection
+}
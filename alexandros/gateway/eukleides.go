@@ -3,25 +3,50 @@ package gateway
 import (
 	"context"
 
-	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
 	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 )
 
-func (a *AlexandrosHandler) pushToEukleides(update *pbe.CountCreationRequest) {
-	var collector pbe.CountCreationRequestSet
-	collector.Request = append(collector.Request, update)
+type uncountedKey struct{}
 
-	err := a.CounterStreamer.Send(&collector)
-	if err != nil {
-		logging.Error(err.Error())
+// withoutCount marks the calls made under ctx as part of a search that is counted once as a whole, like the unified
+// search, so the strategies it calls leave their own count out.
+func withoutCount(ctx context.Context) context.Context {
+	return context.WithValue(ctx, uncountedKey{}, true)
+}
+
+func counted(ctx context.Context) bool {
+	uncounted, _ := ctx.Value(uncountedKey{}).(bool)
+	return !uncounted
+}
+
+// pushToEukleides hands the update to the ingester, which batches it and never blocks the search, and to the
+// searches subscribers. Callers defer it before the search runs and fill in ResultCount once it returns, so a failed
+// search is counted without one and Eukleides can tell the words that found nothing from those that failed. Calls
+// made on behalf of a search that counts itself are skipped.
+func (a *AlexandrosHandler) pushToEukleides(ctx context.Context, update *pbe.CountCreationRequest) {
+	if !counted(ctx) {
+		return
+	}
+
+	a.publishSearch(update)
+
+	if a.Ingester == nil {
+		return
 	}
+
+	a.Ingester.Push(update)
 }
 
-func (a *AlexandrosHandler) TopFive(ctx context.Context) (*model.EukleidesTopFiveResponse, error) {
+func (a *AlexandrosHandler) TopFive(ctx context.Context, window pbe.Window) (*model.EukleidesTopFiveResponse, error) {
+	var response *pbe.TopFiveResponse
+	var err error
 
-	in := &pbe.TopFiveRequest{}
-	response, err := a.Counter.RetrieveTopFive(ctx, in)
+	if window == pbe.Window_ALL_TIME {
+		response, err = a.Counter.RetrieveTopFive(ctx, &pbe.TopFiveRequest{})
+	} else {
+		response, err = a.Counter.RetrieveTopFiveWindow(ctx, &pbe.TopFiveWindowRequest{Window: window})
+	}
 
 	if err != nil {
 		return nil, err
@@ -42,6 +67,45 @@ func (a *AlexandrosHandler) TopFive(ctx context.Context) (*model.EukleidesTopFiv
 	return &out, nil
 }
 
+func (a *AlexandrosHandler) Top(ctx context.Context, in *pbe.TopRequest) (*model.EukleidesTopPage, error) {
+	response, err := a.Counter.RetrieveTop(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return topPage(response), nil
+}
+
+func (a *AlexandrosHandler) ZeroResults(ctx context.Context, in *pbe.ZeroResultsRequest) (*model.EukleidesTopPage, error) {
+	response, err := a.Counter.RetrieveZeroResults(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return topPage(response), nil
+}
+
+func topPage(response *pbe.TopResponse) *model.EukleidesTopPage {
+	out := model.EukleidesTopPage{
+		Entries: []*model.EukleidesTopFive{},
+		Total:   int32(response.Total),
+		Limit:   response.Limit,
+		Offset:  response.Offset,
+	}
+
+	for _, resp := range response.Entries {
+		topFive := model.EukleidesTopFive{
+			ServiceName: resp.ServiceName,
+			Word:        resp.Word,
+			LastUsed:    &resp.LastUsed,
+			Count:       int32(resp.Count),
+		}
+
+		out.Entries = append(out.Entries, &topFive)
+	}
+	return &out
+}
+
 func (a *AlexandrosHandler) TopFiveForSession(ctx context.Context, sessionId string) (*model.EukleidesTopFiveResponse, error) {
 
 	in := &pbe.TopFiveSessionRequest{
@@ -68,7 +132,10 @@ func (a *AlexandrosHandler) TopFiveForSession(ctx context.Context, sessionId str
 	return &out, nil
 }
 
-func (a *AlexandrosHandler) TopFiveForService(ctx context.Context, name string) (*model.EukleidesTopFive, error) {
+func (a *AlexandrosHandler) TopFiveForService(ctx context.Context, name string, window pbe.Window) (*model.EukleidesTopFive, error) {
+	if window != pbe.Window_ALL_TIME {
+		return a.topFiveForServiceInWindow(ctx, name, window)
+	}
 
 	in := &pbe.TopFiveServiceRequest{
 		Name: name,
@@ -88,3 +155,55 @@ func (a *AlexandrosHandler) TopFiveForService(ctx context.Context, name string)
 
 	return &out, nil
 }
+
+func (a *AlexandrosHandler) topFiveForServiceInWindow(ctx context.Context, name string, window pbe.Window) (*model.EukleidesTopFive, error) {
+	in := &pbe.TopFiveWindowRequest{
+		Window:      window,
+		ServiceName: name,
+	}
+	response, err := a.Counter.RetrieveTopFiveWindow(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// mirrors RetrieveTopFiveService, which answers with an empty entry when nothing was counted
+	top := &pbe.TopFive{}
+	if len(response.TopFive) > 0 {
+		top = response.TopFive[0]
+	}
+
+	out := model.EukleidesTopFive{
+		ServiceName: top.ServiceName,
+		Word:        top.Word,
+		LastUsed:    &top.LastUsed,
+		Count:       int32(top.Count),
+	}
+
+	return &out, nil
+}
+
+func (a *AlexandrosHandler) Trending(ctx context.Context, in *pbe.TrendingRequest) ([]*model.TrendingWord, error) {
+	response, err := a.Counter.RetrieveTrending(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.TrendingWord, 0, len(response.Trending))
+	for _, trend := range response.Trending {
+		out = append(out, &model.TrendingWord{
+			ServiceName:     trend.ServiceName,
+			Word:            trend.Word,
+			RecentCount:     int32(trend.RecentCount),
+			BaselineAverage: trend.BaselineAverage,
+			Score:           trend.Score,
+			LastUsed:        &trend.LastUsed,
+		})
+	}
+	return out, nil
+}
+
+// ExportCounters opens the export stream on the caller's context; exports can run longer than outgoingCtx allows.
+func (a *AlexandrosHandler) ExportCounters(ctx context.Context, in *pbe.ExportRequest) (pbe.Eukleides_ExportCountersClient, error) {
+	return a.Counter.ExportCounters(ctx, in)
+}
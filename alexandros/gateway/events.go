@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it misses some.
+const subscriberBuffer = 64
+
+// Broker hands every published event to the subscribers of this replica. A subscriber that does not keep up misses
+// events rather than slowing down the searches that publish them.
+type Broker[T any] struct {
+	mu          sync.Mutex
+	subscribers map[chan T]struct{}
+}
+
+func NewBroker[T any]() *Broker[T] {
+	return &Broker[T]{subscribers: make(map[chan T]struct{})}
+}
+
+// Subscribe returns a channel of events that is closed once ctx is done.
+func (b *Broker[T]) Subscribe(ctx context.Context) <-chan T {
+	events := make(chan T, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subscribers[events] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, events)
+		close(events)
+		b.mu.Unlock()
+	}()
+
+	return events
+}
+
+func (b *Broker[T]) Publish(event T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+}
+
+// SearchEvent is what the searches subscription publishes. The session is only used to filter, it is never sent: the
+// feed is open to anyone who can reach the gateway.
+type SearchEvent struct {
+	*model.SearchEvent
+	session string
+}
+
+// publishSearch tells the searches subscribers about a count on its way to Eukleides.
+func (a *AlexandrosHandler) publishSearch(update *pbe.CountCreationRequest) {
+	if a.SearchEvents == nil {
+		return
+	}
+
+	event := &model.SearchEvent{
+		Word:        update.Word,
+		ServiceName: update.ServiceName,
+		SearchType:  update.SearchType,
+		Time:        time.Now().Format(time.RFC3339),
+	}
+	if update.Language != "" {
+		event.Language = &update.Language
+	}
+	if update.ResultCount != nil {
+		count := int32(*update.ResultCount)
+		event.ResultCount = &count
+	}
+
+	a.SearchEvents.Publish(SearchEvent{SearchEvent: event, session: update.SessionId})
+}
+
+// SubscribeSearches streams the searches of this replica, only those matching the filters that are set. Filtering on a
+// session only finds the searches of a session id the caller already knows.
+func (a *AlexandrosHandler) SubscribeSearches(ctx context.Context, serviceName, sessionId *string) <-chan *model.SearchEvent {
+	events := a.SearchEvents.Subscribe(ctx)
+	filtered := make(chan *model.SearchEvent)
+
+	go func() {
+		defer close(filtered)
+		for event := range events {
+			if serviceName != nil && event.ServiceName != *serviceName {
+				continue
+			}
+			if sessionId != nil && (event.session == "" || event.session != *sessionId) {
+				continue
+			}
+
+			select {
+			case filtered <- event.SearchEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return filtered
+}
+
+// SubscribeDictionary streams the reseed lifecycle as WatchReseeds reads it from eupalinos.
+func (a *AlexandrosHandler) SubscribeDictionary(ctx context.Context) <-chan *model.DictionaryEvent {
+	return a.DictionaryEvents.Subscribe(ctx)
+}
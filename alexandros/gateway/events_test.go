@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	t.Run("FansOutToEverySubscriber", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := NewBroker[string]()
+		first := broker.Subscribe(ctx)
+		second := broker.Subscribe(ctx)
+
+		broker.Publish("λόγος")
+		assert.Equal(t, "λόγος", <-first)
+		assert.Equal(t, "λόγος", <-second)
+	})
+
+	t.Run("SlowSubscriberMissesEvents", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := NewBroker[int]()
+		slow := broker.Subscribe(ctx)
+
+		// publishing never blocks, whatever does not fit the buffer is dropped
+		for i := 0; i < subscriberBuffer*2; i++ {
+			broker.Publish(i)
+		}
+
+		assert.Len(t, slow, subscriberBuffer)
+		assert.Equal(t, 0, <-slow)
+	})
+
+	t.Run("ClosesOnceContextIsDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		broker := NewBroker[string]()
+		events := broker.Subscribe(ctx)
+
+		cancel()
+		assert.Eventually(t, func() bool {
+			select {
+			case _, open := <-events:
+				return !open
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+
+		// publishing to a broker without subscribers is fine
+		broker.Publish("λόγος")
+	})
+}
+
+func TestSubscribeSearches(t *testing.T) {
+	session := "d2f1c0de"
+	otherSession := "a11ce"
+	searches := []*pbe.CountCreationRequest{
+		{Word: "λόγος", ServiceName: "hefaistion", SearchType: "exact", SessionId: session},
+		{Word: "θεός", ServiceName: "antigonos", SearchType: "fuzzy", SessionId: session},
+		{Word: "ἄνθρωπος", ServiceName: "hefaistion", SearchType: "exact", SessionId: otherSession},
+		{Word: "πόλις", ServiceName: "hefaistion", SearchType: "exact"},
+	}
+
+	receive := func(serviceName, sessionId *string) []string {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		handler := &AlexandrosHandler{SearchEvents: NewBroker[SearchEvent]()}
+		events := handler.SubscribeSearches(ctx, serviceName, sessionId)
+		for _, search := range searches {
+			handler.publishSearch(search)
+		}
+
+		var words []string
+		for {
+			select {
+			case event := <-events:
+				words = append(words, event.Word)
+			case <-time.After(50 * time.Millisecond):
+				return words
+			}
+		}
+	}
+
+	t.Run("Unfiltered", func(t *testing.T) {
+		assert.Equal(t, []string{"λόγος", "θεός", "ἄνθρωπος", "πόλις"}, receive(nil, nil))
+	})
+
+	t.Run("ByService", func(t *testing.T) {
+		service := "hefaistion"
+		assert.Equal(t, []string{"λόγος", "ἄνθρωπος", "πόλις"}, receive(&service, nil))
+	})
+
+	t.Run("BySession", func(t *testing.T) {
+		assert.Equal(t, []string{"λόγος", "θεός"}, receive(nil, &session))
+	})
+
+	t.Run("EmptySessionMatchesNothing", func(t *testing.T) {
+		empty := ""
+		assert.Empty(t, receive(nil, &empty))
+	})
+
+}
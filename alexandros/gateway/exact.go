@@ -11,6 +11,29 @@ import (
 )
 
 func (a *AlexandrosHandler) Exact(ctx context.Context, request *koinos.SearchQuery) (*model.SearchResponse, error) {
+	grpcResponse, err := a.exact(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return searchResponse(grpcResponse), nil
+}
+
+// ExactConnection runs the same search as Exact and returns it as a Relay connection.
+func (a *AlexandrosHandler) ExactConnection(ctx context.Context, request *koinos.SearchQuery) (*model.LemmaConnection, error) {
+	grpcResponse, err := a.exact(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return lemmaConnection(grpcResponse, request.SearchAfter != ""), nil
+}
+
+func (a *AlexandrosHandler) exact(ctx context.Context, request *koinos.SearchQuery) (*hefaistionv1.SearchResponse, error) {
+	return load(ctx, "hefaistion", request, func() (*hefaistionv1.SearchResponse, error) {
+		return a.callExact(ctx, request)
+	})
+}
+
+func (a *AlexandrosHandler) callExact(ctx context.Context, request *koinos.SearchQuery) (*hefaistionv1.SearchResponse, error) {
 	outCtx, cancel, sessionId := a.outgoingCtx(ctx)
 	defer cancel()
 
@@ -19,109 +42,26 @@ func (a *AlexandrosHandler) Exact(ctx context.Context, request *koinos.SearchQue
 		ServiceName: "exact",
 		SearchType:  "exact",
 		SessionId:   sessionId,
+		Language:    request.Language.String(),
 	}
 
-	go a.pushToEukleides(&eukleidesUpdate)
-
-	var grpcResponse *hefaistionv1.SearchResponse
+	defer a.pushToEukleides(ctx, &eukleidesUpdate)
 
-	err := a.ExactClient.CallWithReconnect(func(client *philia.ExactClient) error {
-		var innerErr error
-		grpcResponse, innerErr = client.Search(outCtx, request)
-		return innerErr
+	grpcResponse, err := cached(a.Cache, "exact", request, &hefaistionv1.SearchResponse{}, func() (*hefaistionv1.SearchResponse, error) {
+		var grpcResponse *hefaistionv1.SearchResponse
+		err := a.ExactClient.CallWithReconnect(func(client *philia.ExactClient) error {
+			var innerErr error
+			grpcResponse, innerErr = client.Search(outCtx, request)
+			return innerErr
+		})
+		return grpcResponse, err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	var lemmas []*model.Lemma
-
-	for _, result := range grpcResponse.Results {
-		// QuickGlosses
-		glosses := make([]*model.LocalizedGloss, 0, len(result.QuickGlosses))
-		for _, gloss := range result.QuickGlosses {
-			glosses = append(glosses, &model.LocalizedGloss{
-				Language: gloss.Language,
-				Gloss:    gloss.Gloss,
-			})
-		}
-
-		// Definitions
-		definitions := make([]*model.Definition, 0, len(result.Definitions))
-		for _, definition := range result.Definitions {
-			def := &model.Definition{
-				Grade:    definition.Grade,
-				Meanings: make([]*model.Meaning, 0, len(definition.Meanings)),
-			}
-			for _, meaning := range definition.Meanings {
-				// Ensure notes is a non-nil slice for [String!]!
-				notes := meaning.Notes
-				if notes == nil {
-					notes = []string{}
-				}
-				// Example is optional (String), nil is fine.
-				def.Meanings = append(def.Meanings, &model.Meaning{
-					Language:   meaning.Language,
-					Definition: meaning.Definition,
-					Notes:      notes,
-					Example:    &meaning.Example,
-				})
-			}
-			definitions = append(definitions, def)
-		}
-
-		// ModernConnections
-		modernConnections := make([]*model.ModernConnection, 0, len(result.ModernConnections))
-		for _, mc := range result.ModernConnections {
-			note := mc.Note // optional
-			modernConnections = append(modernConnections, &model.ModernConnection{
-				Term: mc.Term,
-				Note: &note,
-			})
-		}
+	resultCount := int64(grpcResponse.PageInfo.Total)
+	eukleidesUpdate.ResultCount = &resultCount
 
-		lemma := &model.Lemma{
-			ID:                &result.Id,
-			Headword:          result.Headword,
-			Normalized:        &result.Normalized,
-			LinkedWord:        &result.LinkedWord,
-			PartOfSpeech:      &result.PartOfSpeech,
-			Article:           &result.Article,
-			Gender:            &result.Gender,
-			Noun:              nil,
-			Verb:              nil,
-			QuickGlosses:      glosses,     // non-nil, possibly empty
-			Definitions:       definitions, // non-nil, possibly empty
-			ModernConnections: modernConnections,
-		}
-
-		if result.Noun != nil {
-			lemma.Noun = &model.NounInfo{
-				Declension: &result.Noun.Declension,
-				Genitive:   &result.Noun.Genitive,
-			}
-		}
-		if result.Verb != nil {
-			// Ensure principal parts is non-nil for [String!]! in your SDL
-			parts := result.Verb.PrincipalParts
-			if parts == nil {
-				parts = []string{}
-			}
-			lemma.Verb = &model.VerbInfo{
-				PrincipalParts: parts,
-			}
-		}
-
-		lemmas = append(lemmas, lemma)
-	}
-
-	resp := &model.SearchResponse{
-		Results: lemmas, // if you ever build this from scratch, prefer [] over nil
-		PageInfo: &model.PageInfo{
-			Page:  grpcResponse.PageInfo.Page,
-			Size:  grpcResponse.PageInfo.Size,
-			Total: grpcResponse.PageInfo.Total,
-		},
-	}
-	return resp, nil
+	return grpcResponse, nil
 }
@@ -0,0 +1,167 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	ptolemaiosv1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// similarWordsRequested is how many fuzzy hits are asked for, the exact word itself is skipped among them
+	similarWordsRequested = 20
+	similarWordsReturned  = 5
+)
+
+// ExpandDeadlines bounds every backend call of an expanded exact search on its own, so a slow text search does not
+// hold back the dictionary results.
+type ExpandDeadlines struct {
+	Exact    time.Duration
+	Fuzzy    time.Duration
+	Extended time.Duration
+}
+
+func DefaultExpandDeadlines() ExpandDeadlines {
+	return ExpandDeadlines{
+		Exact:    5 * time.Second,
+		Fuzzy:    3 * time.Second,
+		Extended: 10 * time.Second,
+	}
+}
+
+// BackendError is a failed call to one of the backend services.
+type BackendError struct {
+	Service string
+	Err     error
+}
+
+func (e *BackendError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Service, status.Convert(e.Err).Message())
+}
+
+func (e *BackendError) Unwrap() error {
+	return e.Err
+}
+
+// Code is the gRPC code the service answered with, an expired deadline counts as DeadlineExceeded.
+func (e *BackendError) Code() codes.Code {
+	if code := status.Code(e.Err); code != codes.Unknown {
+		return code
+	}
+	return status.FromContextError(e.Err).Code()
+}
+
+// ExpandedExact is an exact search together with its similar words and text occurrences. A nil part means that call
+// failed, its error is in Errors.
+type ExpandedExact struct {
+	Exact        *model.SearchResponse
+	SimilarWords []*model.Hit
+	FoundInText  *model.AnalyzeTextResponse
+	Errors       []*BackendError
+}
+
+// ExpandExact calls Hefaistion, Antigonos and Ptolemaios at the same time, each under its own deadline.
+func (a *AlexandrosHandler) ExpandExact(ctx context.Context, request *koinos.SearchQuery) *ExpandedExact {
+	deadlines := a.ExpandDeadlines
+	if deadlines == (ExpandDeadlines{}) {
+		deadlines = DefaultExpandDeadlines()
+	}
+
+	var (
+		expanded           ExpandedExact
+		exactErr, fuzzyErr error
+		extendedErr        error
+		fuzzyResponse      *model.SearchResponse
+		wg                 sync.WaitGroup
+	)
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		callCtx, cancel := context.WithTimeout(ctx, deadlines.Exact)
+		defer cancel()
+		expanded.Exact, exactErr = a.Exact(callCtx, request)
+	}()
+	go func() {
+		defer wg.Done()
+		callCtx, cancel := context.WithTimeout(ctx, deadlines.Fuzzy)
+		defer cancel()
+		fuzzyResponse, fuzzyErr = a.Fuzzy(callCtx, &koinos.SearchQuery{
+			Word:            request.Word,
+			Language:        request.Language,
+			NumberOfResults: similarWordsRequested,
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		callCtx, cancel := context.WithTimeout(ctx, deadlines.Extended)
+		defer cancel()
+		expanded.FoundInText, extendedErr = a.Extended(callCtx, &ptolemaiosv1.ExtendedSearch{Word: request.Word})
+	}()
+	wg.Wait()
+
+	if exactErr != nil {
+		expanded.Exact = nil
+		expanded.Errors = append(expanded.Errors, &BackendError{Service: "hefaistion", Err: exactErr})
+	}
+	if fuzzyErr != nil {
+		expanded.Errors = append(expanded.Errors, &BackendError{Service: "antigonos", Err: fuzzyErr})
+	} else {
+		expanded.SimilarWords = similarWords(request.Word, fuzzyResponse)
+	}
+	if extendedErr != nil {
+		expanded.FoundInText = nil
+		expanded.Errors = append(expanded.Errors, &BackendError{Service: "ptolemaios", Err: extendedErr})
+	}
+
+	for _, err := range expanded.Errors {
+		logging.Error(fmt.Sprintf("expanding %s failed: %s (%s)", request.Word, err.Error(), err.Code()))
+	}
+
+	return &expanded
+}
+
+// similarWords turns the fuzzy results into hits, leaving out the word that was searched for.
+func similarWords(word string, fuzzyResponse *model.SearchResponse) []*model.Hit {
+	var meros []*model.Hit
+	for _, fuzzy := range fuzzyResponse.Results {
+		// Skip the exact match
+		if fuzzy.Headword == word {
+			continue
+		}
+
+		logging.Debug(fuzzy.Headword)
+
+		headword := fuzzy.Headword
+		hit := model.Hit{
+			English:    nil,
+			Greek:      &headword,
+			LinkedWord: fuzzy.LinkedWord,
+			Original:   fuzzy.Normalized,
+		}
+
+		for _, gloss := range fuzzy.QuickGlosses {
+			if gloss.Language == "en" {
+				hit.English = &gloss.Gloss
+			}
+		}
+
+		meros = append(meros, &hit)
+		if len(meros) >= similarWordsReturned {
+			break
+		}
+	}
+
+	if len(meros) == 0 {
+		logging.Warn(fmt.Sprintf("no meros found for word: %s", word))
+	}
+
+	return meros
+}
@@ -7,9 +7,17 @@ import (
 	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 	"github.com/odysseia-greek/makedonia/ptolemaios/aigyptos"
 	v1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (a *AlexandrosHandler) Extended(ctx context.Context, request *v1.ExtendedSearch) (*model.AnalyzeTextResponse, error) {
+	return load(ctx, "ptolemaios", request, func() (*model.AnalyzeTextResponse, error) {
+		return a.callExtended(ctx, request)
+	})
+}
+
+func (a *AlexandrosHandler) callExtended(ctx context.Context, request *v1.ExtendedSearch) (*model.AnalyzeTextResponse, error) {
 	outCtx, cancel, sessionId := a.outgoingCtx(ctx)
 	defer cancel()
 
@@ -20,7 +28,7 @@ func (a *AlexandrosHandler) Extended(ctx context.Context, request *v1.ExtendedSe
 		SessionId:   sessionId,
 	}
 
-	go a.pushToEukleides(&eukleidesUpdate)
+	defer a.pushToEukleides(ctx, &eukleidesUpdate)
 
 	var grpcResponse *v1.ExtendedSearchResponse
 
@@ -29,10 +37,19 @@ func (a *AlexandrosHandler) Extended(ctx context.Context, request *v1.ExtendedSe
 		grpcResponse, innerErr = client.ExtendedSearch(outCtx, request)
 		return innerErr
 	})
+	if status.Code(err) == codes.NotFound {
+		// a word that occurs in no text is an empty result, not a failure
+		resultCount := int64(0)
+		eukleidesUpdate.ResultCount = &resultCount
+		return &model.AnalyzeTextResponse{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	resultCount := int64(len(grpcResponse.FoundInText.Texts))
+	eukleidesUpdate.ResultCount = &resultCount
+
 	resp := &model.AnalyzeTextResponse{
 		Conjugations: nil,
 		Texts:        nil,
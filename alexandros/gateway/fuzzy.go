@@ -11,6 +11,29 @@ import (
 )
 
 func (a *AlexandrosHandler) Fuzzy(ctx context.Context, request *koinos.SearchQuery) (*model.SearchResponse, error) {
+	grpcResponse, err := a.fuzzy(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return searchResponse(grpcResponse), nil
+}
+
+// FuzzyConnection runs the same search as Fuzzy and returns it as a Relay connection.
+func (a *AlexandrosHandler) FuzzyConnection(ctx context.Context, request *koinos.SearchQuery) (*model.LemmaConnection, error) {
+	grpcResponse, err := a.fuzzy(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return lemmaConnection(grpcResponse, request.SearchAfter != ""), nil
+}
+
+func (a *AlexandrosHandler) fuzzy(ctx context.Context, request *koinos.SearchQuery) (*antigonosv1.SearchResponse, error) {
+	return load(ctx, "antigonos", request, func() (*antigonosv1.SearchResponse, error) {
+		return a.callFuzzy(ctx, request)
+	})
+}
+
+func (a *AlexandrosHandler) callFuzzy(ctx context.Context, request *koinos.SearchQuery) (*antigonosv1.SearchResponse, error) {
 	outCtx, cancel, sessionId := a.outgoingCtx(ctx)
 	defer cancel()
 
@@ -19,30 +42,26 @@ func (a *AlexandrosHandler) Fuzzy(ctx context.Context, request *koinos.SearchQue
 		ServiceName: "fuzzy",
 		SearchType:  "fuzzy",
 		SessionId:   sessionId,
+		Language:    request.Language.String(),
 	}
 
-	go a.pushToEukleides(&eukleidesUpdate)
-
-	var grpcResponse *antigonosv1.SearchResponse
+	defer a.pushToEukleides(ctx, &eukleidesUpdate)
 
-	err := a.FuzzyClient.CallWithReconnect(func(client *monophthalmus.FuzzyClient) error {
-		var innerErr error
-		grpcResponse, innerErr = client.Search(outCtx, request)
-		return innerErr
+	grpcResponse, err := cached(a.Cache, "fuzzy", request, &antigonosv1.SearchResponse{}, func() (*antigonosv1.SearchResponse, error) {
+		var grpcResponse *antigonosv1.SearchResponse
+		err := a.FuzzyClient.CallWithReconnect(func(client *monophthalmus.FuzzyClient) error {
+			var innerErr error
+			grpcResponse, innerErr = client.Search(outCtx, request)
+			return innerErr
+		})
+		return grpcResponse, err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	lemmas := parseResults(grpcResponse.Results)
+	resultCount := int64(grpcResponse.PageInfo.Total)
+	eukleidesUpdate.ResultCount = &resultCount
 
-	resp := &model.SearchResponse{
-		Results: lemmas, // if you ever build this from scratch, prefer [] over nil
-		PageInfo: &model.PageInfo{
-			Page:  grpcResponse.PageInfo.Page,
-			Size:  grpcResponse.PageInfo.Size,
-			Total: grpcResponse.PageInfo.Total,
-		},
-	}
-	return resp, nil
+	return grpcResponse, nil
 }
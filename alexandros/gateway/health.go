@@ -2,143 +2,214 @@ package gateway
 
 import (
 	"context"
+	"errors"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/odysseia-greek/agora/hesiodos"
+	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
-	"github.com/odysseia-greek/makedonia/antigonos/monophthalmus"
-	"github.com/odysseia-greek/makedonia/hefaistion/philia"
-	"github.com/odysseia-greek/makedonia/parmenion/strategos"
-	"github.com/odysseia-greek/makedonia/perdikkas/epimeleia"
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
 )
 
+// DefaultHealthDeadline bounds every check on its own, a hanging service shows up as unhealthy instead of stalling
+// the whole health query.
+const DefaultHealthDeadline = 2 * time.Second
+
+var errUnhealthy = errors.New("service reported itself unhealthy")
+
+// koinosHealthClient is implemented by the clients of every service answering with a koinos.v1.HealthResponse.
+type koinosHealthClient interface {
+	Health(ctx context.Context, request *emptypb.Empty) (*koinos.HealthResponse, error)
+}
+
+type healthCheck struct {
+	name string
+	// critical services take alexandros out of rotation when they fail, the others only degrade it
+	critical bool
+	check    func(ctx context.Context) (*model.ServiceHealth, error)
+}
+
+// Health checks every backend and the tracer at the same time, each under HealthDeadline.
 func (a *AlexandrosHandler) Health(ctx context.Context) (*model.AggregatedHealthResponse, error) {
-	var services []*model.ServiceHealth
-	allHealthy := true
+	checks := []healthCheck{
+		{name: "fuzzy", critical: true, check: koinosCheck(a.FuzzyClient)},
+		{name: "exact", critical: true, check: koinosCheck(a.ExactClient)},
+		{name: "phrase", critical: true, check: koinosCheck(a.PhraseClient)},
+		{name: "partial", critical: true, check: koinosCheck(a.PartialClient)},
+		{name: "ptolemaios", critical: true, check: koinosCheck(a.ExtendedClient)},
+		// searches go on without counts, the ingester spills them until eukleides is back
+		{name: "eukleides", check: a.eukleidesCheck},
+		{name: "tracer", check: a.tracerCheck},
+	}
 
-	type healthCheck struct {
-		name   string
-		client func(ctx context.Context) (bool, *model.DatabaseInfo, *string) // healthy, dbHealthy, version
+	deadline := a.HealthDeadline
+	if deadline <= 0 {
+		deadline = DefaultHealthDeadline
 	}
 
-	checks := []healthCheck{
-		{
-			name: "fuzzy",
-			client: func(ctx context.Context) (bool, *model.DatabaseInfo, *string) {
-				var resp *koinos.HealthResponse
-				err := a.FuzzyClient.CallWithReconnect(func(c *monophthalmus.FuzzyClient) error {
-					var innerErr error
-					resp, innerErr = c.Health(ctx, &emptypb.Empty{})
-					return innerErr
-				})
-				if err != nil || resp == nil {
-					return false, nil, nil
-				}
-				databaseHealth := &model.DatabaseInfo{
-					Healthy:       resp.DatabaseHealth.Healthy,
-					ClusterName:   &resp.DatabaseHealth.ClusterName,
-					ServerName:    &resp.DatabaseHealth.ServerName,
-					ServerVersion: &resp.DatabaseHealth.ServerVersion,
-				}
-				return resp.GetHealthy(), databaseHealth, ptr(resp.GetVersion())
-			},
-		},
-		{
-			name: "exact",
-			client: func(ctx context.Context) (bool, *model.DatabaseInfo, *string) {
-				var resp *koinos.HealthResponse
-				err := a.ExactClient.CallWithReconnect(func(c *philia.ExactClient) error {
-					var innerErr error
-					resp, innerErr = c.Health(ctx, &emptypb.Empty{})
-					return innerErr
-				})
-				if err != nil || resp == nil {
-					return false, nil, nil
-				}
-				databaseHealth := &model.DatabaseInfo{
-					Healthy:       resp.DatabaseHealth.Healthy,
-					ClusterName:   &resp.DatabaseHealth.ClusterName,
-					ServerName:    &resp.DatabaseHealth.ServerName,
-					ServerVersion: &resp.DatabaseHealth.ServerVersion,
-				}
-				return resp.GetHealthy(), databaseHealth, ptr(resp.GetVersion())
-			},
-		},
-		{
-			name: "phrase",
-			client: func(ctx context.Context) (bool, *model.DatabaseInfo, *string) {
-				var resp *koinos.HealthResponse
-				err := a.PhraseClient.CallWithReconnect(func(c *strategos.PhraseClient) error {
-					var innerErr error
-					resp, innerErr = c.Health(ctx, &emptypb.Empty{})
-					return innerErr
-				})
-				if err != nil || resp == nil {
-					return false, nil, nil
-				}
-				databaseHealth := &model.DatabaseInfo{
-					Healthy:       resp.DatabaseHealth.Healthy,
-					ClusterName:   &resp.DatabaseHealth.ClusterName,
-					ServerName:    &resp.DatabaseHealth.ServerName,
-					ServerVersion: &resp.DatabaseHealth.ServerVersion,
-				}
-				return resp.GetHealthy(), databaseHealth, ptr(resp.GetVersion())
-			},
-		},
-		{
-			name: "partial",
-			client: func(ctx context.Context) (bool, *model.DatabaseInfo, *string) {
-				var resp *koinos.HealthResponse
-				err := a.PartialClient.CallWithReconnect(func(c *epimeleia.PartialClient) error {
-					var innerErr error
-					resp, innerErr = c.Health(ctx, &emptypb.Empty{})
-					return innerErr
-				})
-				if err != nil || resp == nil {
-					return false, nil, nil
-				}
-				databaseHealth := &model.DatabaseInfo{
-					Healthy:       resp.DatabaseHealth.Healthy,
-					ClusterName:   &resp.DatabaseHealth.ClusterName,
-					ServerName:    &resp.DatabaseHealth.ServerName,
-					ServerVersion: &resp.DatabaseHealth.ServerVersion,
-				}
-				return resp.GetHealthy(), databaseHealth, ptr(resp.GetVersion())
-			},
-		},
-	}
-
-	for _, check := range checks {
-		outCtx, cancel, _ := a.outgoingCtx(ctx)
-		defer cancel()
-		healthy, dbHealthy, version := check.client(outCtx)
-		cancel()
+	services := make([]*model.ServiceHealth, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			services[i] = a.runHealthCheck(ctx, check, deadline)
+		}()
+	}
+	wg.Wait()
 
-		serviceHealth := &model.ServiceHealth{
-			Name:         check.name,
-			Healthy:      healthy,
-			DatabaseInfo: dbHealthy,
-			Version:      version,
+	healthy, degraded := true, false
+	for _, service := range services {
+		if service.Healthy {
+			continue
 		}
-
-		if !healthy {
-			allHealthy = false
+		if service.Critical {
+			healthy = false
+		} else {
+			degraded = true
 		}
-
-		services = append(services, serviceHealth)
 	}
 
 	return &model.AggregatedHealthResponse{
-		Healthy:  allHealthy,
+		Healthy:  healthy,
+		Degraded: degraded,
 		Time:     ptr(time.Now().Format(time.RFC3339)),
 		Version:  ptr(os.Getenv("VERSION")),
 		Services: services,
+		Cache:    a.cacheHealth(),
+		Ingester: a.ingesterHealth(),
 	}, nil
 }
 
+func (a *AlexandrosHandler) runHealthCheck(ctx context.Context, check healthCheck, deadline time.Duration) *model.ServiceHealth {
+	outCtx, cancel, _ := a.outgoingCtx(ctx)
+	defer cancel()
+	checkCtx, cancelCheck := context.WithTimeout(outCtx, deadline)
+	defer cancelCheck()
+
+	start := time.Now()
+	serviceHealth, err := check.check(checkCtx)
+	if serviceHealth == nil {
+		serviceHealth = &model.ServiceHealth{}
+	}
+
+	serviceHealth.Name = check.name
+	serviceHealth.Critical = check.critical
+	serviceHealth.LatencyMs = int32(time.Since(start).Milliseconds())
+	serviceHealth.Healthy = err == nil
+	if err != nil {
+		serviceHealth.Error = ptr(err.Error())
+	}
+
+	return serviceHealth
+}
+
+// koinosCheck calls Health on one of the search services or Ptolemaios.
+func koinosCheck[T koinosHealthClient](client *hesiodos.GenericGrpcClient[T]) func(ctx context.Context) (*model.ServiceHealth, error) {
+	return func(ctx context.Context) (*model.ServiceHealth, error) {
+		if client == nil {
+			return nil, errors.New("no client configured")
+		}
+
+		var resp *koinos.HealthResponse
+		err := client.CallWithReconnect(func(c T) error {
+			var innerErr error
+			resp, innerErr = c.Health(ctx, &emptypb.Empty{})
+			return innerErr
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		serviceHealth := &model.ServiceHealth{
+			Version: ptr(resp.GetVersion()),
+		}
+		if database := resp.GetDatabaseHealth(); database != nil {
+			serviceHealth.DatabaseInfo = &model.DatabaseInfo{
+				Healthy:       database.Healthy,
+				ClusterName:   &database.ClusterName,
+				ServerName:    &database.ServerName,
+				ServerVersion: &database.ServerVersion,
+			}
+		}
+
+		if !resp.GetHealthy() {
+			return serviceHealth, errUnhealthy
+		}
+		return serviceHealth, nil
+	}
+}
+
+func (a *AlexandrosHandler) eukleidesCheck(ctx context.Context) (*model.ServiceHealth, error) {
+	if a.Counter == nil {
+		return nil, errors.New("no client configured")
+	}
+
+	resp, err := a.Counter.Health(ctx, &pbe.HealthRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	serviceHealth := &model.ServiceHealth{
+		Version: ptr(resp.GetVersion()),
+	}
+	if !resp.GetHealthy() {
+		return serviceHealth, errUnhealthy
+	}
+	return serviceHealth, nil
+}
+
+func (a *AlexandrosHandler) tracerCheck(ctx context.Context) (*model.ServiceHealth, error) {
+	if a.Tracer == nil {
+		return nil, errors.New("no client configured")
+	}
+
+	resp, err := a.Tracer.HealthCheck(ctx, &arv1.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetStatus() {
+		return &model.ServiceHealth{}, errUnhealthy
+	}
+	return &model.ServiceHealth{}, nil
+}
+
+func (a *AlexandrosHandler) cacheHealth() *model.CacheHealth {
+	if a.Cache == nil {
+		return nil
+	}
+
+	stats := a.Cache.Stats()
+	return &model.CacheHealth{
+		Backend:   stats.Backend,
+		Entries:   int32(stats.Entries),
+		Hits:      int32(stats.Hits),
+		Misses:    int32(stats.Misses),
+		Evictions: int32(stats.Evictions),
+		Purges:    int32(stats.Purges),
+	}
+}
+
+func (a *AlexandrosHandler) ingesterHealth() *model.IngesterHealth {
+	if a.Ingester == nil {
+		return nil
+	}
+
+	stats := a.Ingester.Stats()
+	return &model.IngesterHealth{
+		Sent:       int32(stats.Sent),
+		Dropped:    int32(stats.Dropped),
+		Spilled:    int32(stats.Spilled),
+		Reconnects: int32(stats.Reconnects),
+		Queued:     int32(stats.Queued),
+	}
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
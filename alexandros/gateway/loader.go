@@ -0,0 +1,98 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type loaderKey struct{}
+
+// Loader remembers the backend calls made while resolving a single GraphQL operation. Fields that ask a service the
+// same thing, e.g. aliases of exact with the same word, share one call and one Eukleides count. Calls for different
+// words still go out one by one; they can be grouped here once the services offer a batch search.
+type Loader struct {
+	mu    sync.Mutex
+	calls map[string]*loaderCall
+}
+
+type loaderCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// WithLoader returns a context whose backend calls are deduplicated until the context is dropped.
+func WithLoader(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loaderKey{}, &Loader{calls: make(map[string]*loaderCall)})
+}
+
+func loaderFrom(ctx context.Context) *Loader {
+	loader, _ := ctx.Value(loaderKey{}).(*Loader)
+	return loader
+}
+
+// load runs fn once per service and request within an operation, concurrent callers wait for the first one. The
+// outcome is shared, errors included, except when the call ran out of the deadline or was canceled: that belongs to
+// the context of the first caller, so the others try again under their own.
+func load[T any](ctx context.Context, service string, request proto.Message, fn func() (T, error)) (T, error) {
+	loader := loaderFrom(ctx)
+	if loader == nil {
+		return fn()
+	}
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return fn()
+	}
+	key := service + "\x00" + string(raw)
+	if !counted(ctx) {
+		// a call made for a unified search leaves the count out, so it cannot stand in for one that counts itself
+		key = "uncounted\x00" + key
+	}
+
+	loader.mu.Lock()
+	if call, ok := loader.calls[key]; ok {
+		loader.mu.Unlock()
+		<-call.done
+		if contextError(call.err) {
+			return load(ctx, service, request, fn)
+		}
+		value, _ := call.value.(T)
+		return value, call.err
+	}
+	call := &loaderCall{done: make(chan struct{})}
+	loader.calls[key] = call
+	loader.mu.Unlock()
+
+	func() {
+		// waiters are released even when fn panics
+		defer close(call.done)
+		call.value, call.err = fn()
+		if contextError(call.err) {
+			// later callers start over instead of inheriting the expired context
+			loader.mu.Lock()
+			if loader.calls[key] == call {
+				delete(loader.calls, key)
+			}
+			loader.mu.Unlock()
+		}
+	}()
+
+	value, _ := call.value.(T)
+	return value, call.err
+}
+
+// contextError reports whether err comes from the context of the call rather than from the service, either directly or
+// as the gRPC status it was turned into.
+func contextError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+	code := status.Code(err)
+	return code == codes.DeadlineExceeded || code == codes.Canceled
+}
@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoad(t *testing.T) {
+	request := &koinos.SearchQuery{Word: "λόγος"}
+
+	// share runs a first caller that answers with firstErr once a second caller for the same request waits on it
+	share := func(firstErr error) (string, int, error) {
+		ctx := WithLoader(context.Background())
+		started, release := make(chan struct{}), make(chan struct{})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = load(ctx, "hefaistion", request, func() (string, error) {
+				close(started)
+				<-release
+				return "", firstErr
+			})
+		}()
+		<-started
+
+		calls := 0
+		var value string
+		var err error
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err = load(ctx, "hefaistion", request, func() (string, error) {
+				calls++
+				return "λόγος", nil
+			})
+		}()
+
+		// the second caller is waiting on the first by now, or finds its outcome once it is done
+		close(release)
+		wg.Wait()
+		return value, calls, err
+	}
+
+	t.Run("SharesBackendErrors", func(t *testing.T) {
+		unavailable := status.Error(codes.Unavailable, "no shards")
+		value, calls, err := share(unavailable)
+		assert.Equal(t, unavailable, err)
+		assert.Empty(t, value)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("RetriesAfterADeadlineOfTheFirstCaller", func(t *testing.T) {
+		for _, expired := range []error{
+			context.DeadlineExceeded,
+			status.Error(codes.DeadlineExceeded, "context deadline exceeded"),
+			status.Error(codes.Canceled, "context canceled"),
+		} {
+			value, calls, err := share(expired)
+			assert.Nil(t, err)
+			assert.Equal(t, "λόγος", value)
+			assert.Equal(t, 1, calls)
+		}
+	})
+
+	t.Run("SharesResults", func(t *testing.T) {
+		ctx := WithLoader(context.Background())
+		first, err := load(ctx, "hefaistion", request, func() (string, error) { return "λόγος", nil })
+		assert.Nil(t, err)
+
+		second, err := load(ctx, "hefaistion", request, func() (string, error) { return "", errors.New("called twice") })
+		assert.Nil(t, err)
+		assert.Equal(t, first, second)
+	})
+}
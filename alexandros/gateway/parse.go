@@ -5,6 +5,13 @@ import (
 	koinosv1 "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
 )
 
+func searchResponse(grpcResponse lemmaSearch) *model.SearchResponse {
+	return &model.SearchResponse{
+		Results:  parseResults(grpcResponse.GetResults()), // if you ever build this from scratch, prefer [] over nil
+		PageInfo: parsePageInfo(grpcResponse.GetPageInfo()),
+	}
+}
+
 func parseResults(results []*koinosv1.Lemma) []*model.Lemma {
 	var lemmas []*model.Lemma
 
@@ -89,3 +96,16 @@ func parseResults(results []*koinosv1.Lemma) []*model.Lemma {
 
 	return lemmas
 }
+
+func parsePageInfo(info *koinosv1.PageInfo) *model.PageInfo {
+	pageInfo := &model.PageInfo{
+		Page:        info.Page,
+		Size:        info.Size,
+		Total:       info.Total,
+		HasNextPage: info.HasNextPage,
+	}
+	if info.NextCursor != "" {
+		pageInfo.NextCursor = &info.NextCursor
+	}
+	return pageInfo
+}
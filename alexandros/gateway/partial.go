@@ -11,6 +11,29 @@ import (
 )
 
 func (a *AlexandrosHandler) Partial(ctx context.Context, request *koinos.SearchQuery) (*model.SearchResponse, error) {
+	grpcResponse, err := a.partial(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return searchResponse(grpcResponse), nil
+}
+
+// PartialConnection runs the same search as Partial and returns it as a Relay connection.
+func (a *AlexandrosHandler) PartialConnection(ctx context.Context, request *koinos.SearchQuery) (*model.LemmaConnection, error) {
+	grpcResponse, err := a.partial(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return lemmaConnection(grpcResponse, request.SearchAfter != ""), nil
+}
+
+func (a *AlexandrosHandler) partial(ctx context.Context, request *koinos.SearchQuery) (*perdikkasv1.SearchResponse, error) {
+	return load(ctx, "perdikkas", request, func() (*perdikkasv1.SearchResponse, error) {
+		return a.callPartial(ctx, request)
+	})
+}
+
+func (a *AlexandrosHandler) callPartial(ctx context.Context, request *koinos.SearchQuery) (*perdikkasv1.SearchResponse, error) {
 	outCtx, cancel, sessionId := a.outgoingCtx(ctx)
 	defer cancel()
 
@@ -19,31 +42,26 @@ func (a *AlexandrosHandler) Partial(ctx context.Context, request *koinos.SearchQ
 		ServiceName: "partial",
 		SearchType:  "partial",
 		SessionId:   sessionId,
+		Language:    request.Language.String(),
 	}
 
-	go a.pushToEukleides(&eukleidesUpdate)
-
-	var grpcResponse *perdikkasv1.SearchResponse
+	defer a.pushToEukleides(ctx, &eukleidesUpdate)
 
-	err := a.PartialClient.CallWithReconnect(func(client *epimeleia.PartialClient) error {
-		var innerErr error
-		grpcResponse, innerErr = client.Search(outCtx, request)
-		return innerErr
+	grpcResponse, err := cached(a.Cache, "partial", request, &perdikkasv1.SearchResponse{}, func() (*perdikkasv1.SearchResponse, error) {
+		var grpcResponse *perdikkasv1.SearchResponse
+		err := a.PartialClient.CallWithReconnect(func(client *epimeleia.PartialClient) error {
+			var innerErr error
+			grpcResponse, innerErr = client.Search(outCtx, request)
+			return innerErr
+		})
+		return grpcResponse, err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	lemmas := parseResults(grpcResponse.Results)
-
-	resp := &model.SearchResponse{
-		Results: lemmas, // if you ever build this from scratch, prefer [] over nil
-		PageInfo: &model.PageInfo{
-			Page:  grpcResponse.PageInfo.Page,
-			Size:  grpcResponse.PageInfo.Size,
-			Total: grpcResponse.PageInfo.Total,
-		},
-	}
+	resultCount := int64(grpcResponse.PageInfo.Total)
+	eukleidesUpdate.ResultCount = &resultCount
 
-	return resp, nil
+	return grpcResponse, nil
 }
@@ -11,6 +11,29 @@ import (
 )
 
 func (a *AlexandrosHandler) Phrase(ctx context.Context, request *koinos.SearchQuery) (*model.SearchResponse, error) {
+	grpcResponse, err := a.phrase(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return searchResponse(grpcResponse), nil
+}
+
+// PhraseConnection runs the same search as Phrase and returns it as a Relay connection.
+func (a *AlexandrosHandler) PhraseConnection(ctx context.Context, request *koinos.SearchQuery) (*model.LemmaConnection, error) {
+	grpcResponse, err := a.phrase(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return lemmaConnection(grpcResponse, request.SearchAfter != ""), nil
+}
+
+func (a *AlexandrosHandler) phrase(ctx context.Context, request *koinos.SearchQuery) (*parmenionv1.SearchResponse, error) {
+	return load(ctx, "parmenion", request, func() (*parmenionv1.SearchResponse, error) {
+		return a.callPhrase(ctx, request)
+	})
+}
+
+func (a *AlexandrosHandler) callPhrase(ctx context.Context, request *koinos.SearchQuery) (*parmenionv1.SearchResponse, error) {
 	outCtx, cancel, sessionId := a.outgoingCtx(ctx)
 	defer cancel()
 
@@ -19,31 +42,26 @@ func (a *AlexandrosHandler) Phrase(ctx context.Context, request *koinos.SearchQu
 		ServiceName: "phrase",
 		SearchType:  "phrase",
 		SessionId:   sessionId,
+		Language:    request.Language.String(),
 	}
 
-	go a.pushToEukleides(&eukleidesUpdate)
-
-	var grpcResponse *parmenionv1.SearchResponse
+	defer a.pushToEukleides(ctx, &eukleidesUpdate)
 
-	err := a.PhraseClient.CallWithReconnect(func(client *strategos.PhraseClient) error {
-		var innerErr error
-		grpcResponse, innerErr = client.Search(outCtx, request)
-		return innerErr
+	grpcResponse, err := cached(a.Cache, "phrase", request, &parmenionv1.SearchResponse{}, func() (*parmenionv1.SearchResponse, error) {
+		var grpcResponse *parmenionv1.SearchResponse
+		err := a.PhraseClient.CallWithReconnect(func(client *strategos.PhraseClient) error {
+			var innerErr error
+			grpcResponse, innerErr = client.Search(outCtx, request)
+			return innerErr
+		})
+		return grpcResponse, err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	lemmas := parseResults(grpcResponse.Results)
-
-	resp := &model.SearchResponse{
-		Results: lemmas, // if you ever build this from scratch, prefer [] over nil
-		PageInfo: &model.PageInfo{
-			Page:  grpcResponse.PageInfo.Page,
-			Size:  grpcResponse.PageInfo.Size,
-			Total: grpcResponse.PageInfo.Total,
-		},
-	}
+	resultCount := int64(grpcResponse.PageInfo.Total)
+	eukleidesUpdate.ResultCount = &resultCount
 
-	return resp, nil
+	return grpcResponse, nil
 }
@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pbe "github.com/odysseia-greek/agora/eupalinos/proto"
+	"github.com/odysseia-greek/agora/eupalinos/stomion"
+	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+)
+
+// The messages demokritos puts on its reseed channel when it starts and finishes reloading the dictionary.
+const (
+	reseedStarted   = "started"
+	reseedCompleted = "completed"
+)
+
+var reseedStatus = map[string]model.DictionaryStatus{
+	reseedStarted:   model.DictionaryStatusReseedStarted,
+	reseedCompleted: model.DictionaryStatusReseedCompleted,
+}
+
+// WatchReseeds polls the reseed channel of demokritos on eupalinos and publishes every reseed message to the dictionary
+// subscribers. Once a reseed completed the response cache is purged, the cached responses describe a dictionary that
+// no longer exists. Reading dequeues, so channel has to belong to this replica alone. It returns when ctx is done.
+func (a *AlexandrosHandler) WatchReseeds(ctx context.Context, queue *stomion.QueueClient, channel string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// a started and a completed message can both be waiting
+		for {
+			message, err := queue.DequeueMessage(ctx, &pbe.ChannelInfo{Name: channel})
+			if err != nil || message.Data == "" {
+				// eupalinos answers with an error or an empty message while the channel is empty or not yet created
+				break
+			}
+
+			a.handleReseed(channel, message.Data)
+		}
+	}
+}
+
+func (a *AlexandrosHandler) handleReseed(channel, data string) {
+	status, ok := reseedStatus[data]
+	if !ok {
+		logging.Debug(fmt.Sprintf("ignoring message %s on %s", data, channel))
+		return
+	}
+
+	if a.DictionaryEvents != nil {
+		a.DictionaryEvents.Publish(&model.DictionaryEvent{
+			Status: status,
+			Time:   time.Now().Format(time.RFC3339),
+		})
+	}
+
+	if status != model.DictionaryStatusReseedCompleted || a.Cache == nil {
+		return
+	}
+
+	if err := a.Cache.Purge(); err != nil {
+		logging.Error(fmt.Sprintf("failed to purge the cache after a reseed: %s", err.Error()))
+		return
+	}
+	logging.System(fmt.Sprintf("cache purged, %s reported a completed reseed", channel))
+}
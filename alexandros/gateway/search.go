@@ -0,0 +1,204 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/odysseia-greek/agora/plato/config"
+	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+)
+
+// strategyOutcome is what a single strategy contributed to a unified search.
+type strategyOutcome struct {
+	strategy model.SearchStrategy
+	response lemmaSearch
+	err      error
+	took     time.Duration
+}
+
+// Search runs the request against every strategy at once and merges the lemmas into a single ranked list. A lemma
+// found by several strategies shows up once, ranked by the strongest of them; a failing strategy is reported in its
+// timing and only fails the search when nothing else answered. Eukleides counts the search once, with the number of
+// merged lemmas it returned.
+func (a *AlexandrosHandler) Search(ctx context.Context, request *koinos.SearchQuery, strategies []model.SearchStrategy) (*model.UnifiedSearchResponse, error) {
+	if len(strategies) == 0 {
+		strategies = model.AllSearchStrategy
+	}
+	strategies = uniqueStrategies(strategies)
+
+	sessionId, _ := ctx.Value(config.SessionIdKey).(string)
+	eukleidesUpdate := pbe.CountCreationRequest{
+		Word:        request.Word,
+		ServiceName: "search",
+		SearchType:  "search",
+		SessionId:   sessionId,
+		Language:    request.Language.String(),
+	}
+
+	// the search is counted once, not once for every strategy it fans out to
+	defer a.pushToEukleides(ctx, &eukleidesUpdate)
+	strategyCtx := withoutCount(ctx)
+
+	outcomes := make([]strategyOutcome, len(strategies))
+	var wg sync.WaitGroup
+	for i, strategy := range strategies {
+		wg.Add(1)
+		go func(i int, strategy model.SearchStrategy) {
+			defer wg.Done()
+			start := time.Now()
+			response, err := a.searchStrategy(strategyCtx, strategy, request)
+			outcomes[i] = strategyOutcome{strategy: strategy, response: response, err: err, took: time.Since(start)}
+		}(i, strategy)
+	}
+	wg.Wait()
+
+	var lastErr error
+	answered := 0
+	timings := make([]*model.StrategyTiming, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		timing := &model.StrategyTiming{
+			Strategy:   outcome.strategy,
+			DurationMs: int32(outcome.took.Milliseconds()),
+		}
+		if outcome.err != nil {
+			logging.Error(fmt.Sprintf("%s strategy failed during unified search: %s", outcome.strategy, outcome.err.Error()))
+			message := outcome.err.Error()
+			timing.Error = &message
+			lastErr = outcome.err
+		} else {
+			answered++
+			timing.Results = int32(len(outcome.response.GetResults()))
+			timing.Total = outcome.response.GetPageInfo().GetTotal()
+		}
+		timings = append(timings, timing)
+	}
+
+	if answered == 0 {
+		return nil, lastErr
+	}
+
+	results := rankLemmas(outcomes)
+	resultCount := int64(len(results))
+	eukleidesUpdate.ResultCount = &resultCount
+
+	return &model.UnifiedSearchResponse{
+		Results: results,
+		Timings: timings,
+	}, nil
+}
+
+func (a *AlexandrosHandler) searchStrategy(ctx context.Context, strategy model.SearchStrategy, request *koinos.SearchQuery) (lemmaSearch, error) {
+	switch strategy {
+	case model.SearchStrategyExact:
+		return a.exact(ctx, request)
+	case model.SearchStrategyPhrase:
+		return a.phrase(ctx, request)
+	case model.SearchStrategyPartial:
+		return a.partial(ctx, request)
+	case model.SearchStrategyFuzzy:
+		return a.fuzzy(ctx, request)
+	default:
+		return nil, fmt.Errorf("unsupported search strategy: %s", strategy)
+	}
+}
+
+// rankLemmas dedupes lemmas by id, or headword when a lemma has no id, and orders them by the strongest strategy
+// that found them (exact, phrase, partial, fuzzy), then by how many strategies agreed, then by their relevance within
+// that strategy.
+func rankLemmas(outcomes []strategyOutcome) []*model.RankedLemma {
+	type candidate struct {
+		ranked   *model.RankedLemma
+		strength int
+		position int
+	}
+
+	candidates := make(map[string]*candidate)
+	order := make([]*candidate, 0)
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			continue
+		}
+
+		strength := strategyStrength(outcome.strategy)
+		for position, lemma := range parseResults(outcome.response.GetResults()) {
+			key := lemma.Headword
+			if lemma.ID != nil && *lemma.ID != "" {
+				key = *lemma.ID
+			}
+
+			existing, ok := candidates[key]
+			if !ok {
+				c := &candidate{
+					ranked: &model.RankedLemma{
+						Lemma:    lemma,
+						Strategy: outcome.strategy,
+						FoundBy:  []model.SearchStrategy{outcome.strategy},
+					},
+					strength: strength,
+					position: position,
+				}
+				candidates[key] = c
+				order = append(order, c)
+				continue
+			}
+
+			if existing.ranked.FoundBy[len(existing.ranked.FoundBy)-1] != outcome.strategy {
+				existing.ranked.FoundBy = append(existing.ranked.FoundBy, outcome.strategy)
+			}
+			if strength < existing.strength || (strength == existing.strength && position < existing.position) {
+				existing.ranked.Lemma = lemma
+				existing.ranked.Strategy = outcome.strategy
+				existing.strength = strength
+				existing.position = position
+			}
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].strength != order[j].strength {
+			return order[i].strength < order[j].strength
+		}
+		if len(order[i].ranked.FoundBy) != len(order[j].ranked.FoundBy) {
+			return len(order[i].ranked.FoundBy) > len(order[j].ranked.FoundBy)
+		}
+		return order[i].position < order[j].position
+	})
+
+	results := make([]*model.RankedLemma, 0, len(order))
+	for i, c := range order {
+		sort.Slice(c.ranked.FoundBy, func(x, y int) bool {
+			return strategyStrength(c.ranked.FoundBy[x]) < strategyStrength(c.ranked.FoundBy[y])
+		})
+		c.ranked.Rank = int32(i + 1)
+		results = append(results, c.ranked)
+	}
+	return results
+}
+
+// strategyStrength is the position of a strategy in the schema, which lists them strongest first.
+func strategyStrength(strategy model.SearchStrategy) int {
+	for i, s := range model.AllSearchStrategy {
+		if s == strategy {
+			return i
+		}
+	}
+	return len(model.AllSearchStrategy)
+}
+
+func uniqueStrategies(strategies []model.SearchStrategy) []model.SearchStrategy {
+	seen := make(map[model.SearchStrategy]bool, len(strategies))
+	unique := make([]model.SearchStrategy, 0, len(strategies))
+	for _, strategy := range strategies {
+		if !seen[strategy] {
+			seen[strategy] = true
+			unique = append(unique, strategy)
+		}
+	}
+	return unique
+}
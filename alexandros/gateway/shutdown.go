@@ -0,0 +1,29 @@
+package gateway
+
+import "errors"
+
+// Drain takes alexandros out of rotation, /readyz answers 503 while the in-flight requests finish.
+func (a *AlexandrosHandler) Drain() {
+	a.draining.Store(true)
+}
+
+func (a *AlexandrosHandler) Draining() bool {
+	return a.draining.Load()
+}
+
+// Close hands the queued counts to eukleides, closes the response cache and ends the trace stream. Call it once the
+// http server has shut down.
+func (a *AlexandrosHandler) Close() error {
+	var errs []error
+	if a.Ingester != nil {
+		a.Ingester.Close()
+	}
+	if a.Cache != nil {
+		errs = append(errs, a.Cache.Close())
+	}
+	if a.Streamer != nil {
+		errs = append(errs, a.Streamer.CloseSend())
+	}
+
+	return errors.Join(errs...)
+}
@@ -5,6 +5,9 @@ go 1.25.5
 require (
 	github.com/99designs/gqlgen v0.17.86
 	github.com/gorilla/mux v1.8.1
+	github.com/gorilla/websocket v1.5.0
+	github.com/odysseia-greek/agora/archytas v0.1.2
+	github.com/odysseia-greek/agora/eupalinos v0.2.7
 	github.com/odysseia-greek/agora/hesiodos v0.1.1
 	github.com/odysseia-greek/agora/plato v0.2.16
 	github.com/odysseia-greek/attike/aristophanes v0.7.2
@@ -15,7 +18,9 @@ require (
 	github.com/odysseia-greek/makedonia/parmenion v0.0.3
 	github.com/odysseia-greek/makedonia/perdikkas v0.0.4
 	github.com/odysseia-greek/makedonia/ptolemaios v0.0.3
+	github.com/stretchr/testify v1.11.1
 	github.com/vektah/gqlparser/v2 v2.5.31
+	golang.org/x/text v0.33.0
 	google.golang.org/grpc v1.78.0
 	google.golang.org/protobuf v1.36.11
 )
@@ -41,7 +46,6 @@ require (
 	github.com/golang/snappy v1.0.0 // indirect
 	github.com/google/flatbuffers v25.2.10+incompatible // indirect
 	github.com/google/uuid v1.6.0 // indirect
-	github.com/gorilla/websocket v1.5.0 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
@@ -57,17 +61,14 @@ require (
 	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
-	github.com/odysseia-greek/agora/archytas v0.1.2 // indirect
 	github.com/odysseia-greek/agora/aristoteles v0.2.2 // indirect
 	github.com/odysseia-greek/agora/diogenes v0.1.15 // indirect
-	github.com/odysseia-greek/agora/eupalinos v0.2.7 // indirect
 	github.com/odysseia-greek/delphi/aristides v0.0.1 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/ryanuber/go-glob v1.0.0 // indirect
 	github.com/sosodev/duration v1.3.1 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
-	github.com/stretchr/testify v1.11.1 // indirect
 	go.opencensus.io v0.24.0 // indirect
 	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
 	go.opentelemetry.io/otel v1.38.0 // indirect
@@ -75,8 +76,17 @@ require (
 	go.opentelemetry.io/otel/trace v1.38.0 // indirect
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
-	golang.org/x/text v0.33.0 // indirect
 	golang.org/x/time v0.3.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace (
+	github.com/odysseia-greek/makedonia/antigonos => ../antigonos
+	github.com/odysseia-greek/makedonia/eukleides => ../eukleides
+	github.com/odysseia-greek/makedonia/filippos => ../filippos
+	github.com/odysseia-greek/makedonia/hefaistion => ../hefaistion
+	github.com/odysseia-greek/makedonia/parmenion => ../parmenion
+	github.com/odysseia-greek/makedonia/perdikkas => ../perdikkas
+	github.com/odysseia-greek/makedonia/ptolemaios => ../ptolemaios
+)
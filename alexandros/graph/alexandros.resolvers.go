@@ -3,31 +3,26 @@ package graph
 // This file will be automatically regenerated based on the schema, any resolver
 // implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.85
+// Code generated by github.com/99designs/gqlgen version v0.17.86
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
-	v1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
+	ptolemaiosv1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
 )
 
-// Health is the resolver for the health field.
-func (r *queryResolver) Health(ctx context.Context) (*model.AggregatedHealthResponse, error) {
-	return r.Handler.Health(ctx)
-}
-
 // CounterTopFive is the resolver for the counterTopFive field.
-func (r *queryResolver) CounterTopFive(ctx context.Context) (*model.EukleidesTopFiveResponse, error) {
-	return r.Handler.TopFive(ctx)
+func (r *queryResolver) CounterTopFive(ctx context.Context, window *model.CounterWindow) (*model.EukleidesTopFiveResponse, error) {
+	return r.Handler.TopFive(ctx, parseWindow(window))
 }
 
 // CounterService is the resolver for the counterService field.
-func (r *queryResolver) CounterService(ctx context.Context, name string) (*model.EukleidesTopFive, error) {
-	return r.Handler.TopFiveForService(ctx, name)
+func (r *queryResolver) CounterService(ctx context.Context, name string, window *model.CounterWindow) (*model.EukleidesTopFive, error) {
+	return r.Handler.TopFiveForService(ctx, name, parseWindow(window))
 }
 
 // CounterSession is the resolver for the CounterSession field.
@@ -35,9 +30,38 @@ func (r *queryResolver) CounterSession(ctx context.Context, sessionID string) (*
 	return r.Handler.TopFiveForSession(ctx, sessionID)
 }
 
+// CounterTop is the resolver for the counterTop field.
+func (r *queryResolver) CounterTop(ctx context.Context, input *model.CounterTopInput) (*model.EukleidesTopPage, error) {
+	return r.Handler.Top(ctx, parseTopInput(input))
+}
+
+// CounterZeroResults is the resolver for the counterZeroResults field.
+func (r *queryResolver) CounterZeroResults(ctx context.Context, input *model.ZeroResultsInput) (*model.EukleidesTopPage, error) {
+	return r.Handler.ZeroResults(ctx, parseZeroResultsInput(input))
+}
+
+// Trending is the resolver for the trending field.
+func (r *queryResolver) Trending(ctx context.Context, window *model.CounterWindow, serviceName *string, limit *int32, minCount *int32) ([]*model.TrendingWord, error) {
+	request := &pbe.TrendingRequest{Window: parseWindow(window)}
+	if serviceName != nil {
+		request.ServiceName = *serviceName
+	}
+	if limit != nil {
+		request.Limit = *limit
+	}
+	if minCount != nil {
+		request.MinCount = int64(*minCount)
+	}
+
+	return r.Handler.Trending(ctx, request)
+}
+
 // Text is the resolver for the text field.
 func (r *queryResolver) Text(ctx context.Context, input model.ExpandableSearchQueryInput) (*model.ExtendedResponse, error) {
-	textResponse, _ := r.Handler.Extended(ctx, &v1.ExtendedSearch{Word: input.Word})
+	textResponse, err := r.Handler.Extended(ctx, &ptolemaiosv1.ExtendedSearch{Word: input.Word})
+	if err != nil {
+		return nil, err
+	}
 
 	response := &model.ExtendedResponse{
 		FoundInText: textResponse,
@@ -53,7 +77,9 @@ func (r *queryResolver) Fuzzy(ctx context.Context, input model.SearchQueryInput)
 	request := &koinos.SearchQuery{
 		Word:            input.Word,
 		Language:        language,
-		NumberOfResults: *input.Size,
+		NumberOfResults: parseSize(input.Size),
+		Page:            parsePage(input.Page),
+		SearchAfter:     parseCursor(input.After),
 	}
 	return r.Handler.Fuzzy(ctx, request)
 }
@@ -65,63 +91,41 @@ func (r *queryResolver) Exact(ctx context.Context, input model.ExpandableSearchQ
 	request := &koinos.SearchQuery{
 		Word:            input.Word,
 		Language:        language,
-		NumberOfResults: *input.Size,
-	}
-	exactResponse, err := r.Handler.Exact(ctx, request)
-	if err != nil {
-		return nil, err
+		NumberOfResults: parseSize(input.Size),
+		Page:            parsePage(input.Page),
+		SearchAfter:     parseCursor(input.After),
 	}
-
-	var meros []*model.Hit
-	var textResponse *model.AnalyzeTextResponse
-	if input.Expand {
-
-		antigonosRequest := &koinos.SearchQuery{
-			Word:            input.Word,
-			Language:        language,
-			NumberOfResults: 20,
-		}
-		fuzzyResponses, _ := r.Handler.Fuzzy(ctx, antigonosRequest)
-
-		for _, fuzzy := range fuzzyResponses.Results {
-			// Skip the exact match
-			if fuzzy.Headword == input.Word {
-				continue
-			}
-
-			logging.Debug(fuzzy.Headword)
-
-			hit := model.Hit{
-				English:    nil,
-				Greek:      &fuzzy.Headword,
-				LinkedWord: fuzzy.LinkedWord,
-				Original:   fuzzy.Normalized,
-			}
-
-			for _, gloss := range fuzzy.QuickGlosses {
-				if gloss.Language == "en" {
-					hit.English = &gloss.Gloss
-				}
-			}
-
-			meros = append(meros, &hit)
-			if len(meros) >= 5 {
-				break
-			}
+	if !input.Expand {
+		exactResponse, err := r.Handler.Exact(ctx, request)
+		if err != nil {
+			return nil, err
 		}
 
-		if len(meros) == 0 {
-			logging.Warn(fmt.Sprintf("no meros found for word: %s", input.Word))
-		}
+		return &model.ExtendedResponse{
+			Results:  exactResponse.Results,
+			PageInfo: exactResponse.PageInfo,
+		}, nil
+	}
 
-		textResponse, _ = r.Handler.Extended(ctx, &v1.ExtendedSearch{Word: request.Word})
+	expanded := r.Handler.ExpandExact(ctx, request)
+	var exactErr error
+	for _, backendErr := range expanded.Errors {
+		if expanded.Exact == nil && backendErr.Service == "hefaistion" {
+			exactErr = backendError(ctx, backendErr)
+			continue
+		}
+		// the other calls only add to the response, so their failures travel next to the data
+		graphql.AddError(ctx, backendError(ctx, backendErr))
+	}
+	if exactErr != nil {
+		return nil, exactErr
 	}
 
 	response := &model.ExtendedResponse{
-		Results:      exactResponse.Results,
-		PageInfo:     exactResponse.PageInfo,
-		SimilarWords: meros,
-		FoundInText:  textResponse,
+		Results:      expanded.Exact.Results,
+		PageInfo:     expanded.Exact.PageInfo,
+		SimilarWords: expanded.SimilarWords,
+		FoundInText:  expanded.FoundInText,
 	}
 
 	return response, nil
@@ -134,7 +138,9 @@ func (r *queryResolver) Phrase(ctx context.Context, input model.SearchQueryInput
 	request := &koinos.SearchQuery{
 		Word:            input.Word,
 		Language:        language,
-		NumberOfResults: *input.Size,
+		NumberOfResults: parseSize(input.Size),
+		Page:            parsePage(input.Page),
+		SearchAfter:     parseCursor(input.After),
 	}
 	return r.Handler.Phrase(ctx, request)
 }
@@ -146,12 +152,56 @@ func (r *queryResolver) Partial(ctx context.Context, input model.SearchQueryInpu
 	request := &koinos.SearchQuery{
 		Word:            input.Word,
 		Language:        language,
-		NumberOfResults: *input.Size,
+		NumberOfResults: parseSize(input.Size),
+		Page:            parsePage(input.Page),
+		SearchAfter:     parseCursor(input.After),
 	}
 	return r.Handler.Partial(ctx, request)
 }
 
-// Query returns QueryResolver implementation.
-func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, input model.UnifiedSearchInput) (*model.UnifiedSearchResponse, error) {
+	request := &koinos.SearchQuery{
+		Word:            input.Word,
+		Language:        parseLanguage(input.Language),
+		NumberOfResults: parseSize(input.Size),
+		// every strategy is asked for its first page only, the merged ranking is not paged
+		Page: 1,
+	}
+	return r.Handler.Search(ctx, request, input.Strategies)
+}
+
+// ExactConnection is the resolver for the exactConnection field.
+func (r *queryResolver) ExactConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error) {
+	return r.Handler.ExactConnection(ctx, parseConnectionQuery(word, language, first, after))
+}
+
+// FuzzyConnection is the resolver for the fuzzyConnection field.
+func (r *queryResolver) FuzzyConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error) {
+	return r.Handler.FuzzyConnection(ctx, parseConnectionQuery(word, language, first, after))
+}
+
+// PhraseConnection is the resolver for the phraseConnection field.
+func (r *queryResolver) PhraseConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error) {
+	return r.Handler.PhraseConnection(ctx, parseConnectionQuery(word, language, first, after))
+}
+
+// PartialConnection is the resolver for the partialConnection field.
+func (r *queryResolver) PartialConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error) {
+	return r.Handler.PartialConnection(ctx, parseConnectionQuery(word, language, first, after))
+}
+
+// Searches is the resolver for the searches field.
+func (r *subscriptionResolver) Searches(ctx context.Context, serviceName *string, sessionID *string) (<-chan *model.SearchEvent, error) {
+	return r.Handler.SubscribeSearches(ctx, serviceName, sessionID), nil
+}
+
+// Dictionary is the resolver for the dictionary field.
+func (r *subscriptionResolver) Dictionary(ctx context.Context) (<-chan *model.DictionaryEvent, error) {
+	return r.Handler.SubscribeDictionary(ctx), nil
+}
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
-type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
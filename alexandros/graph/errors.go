@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/odysseia-greek/makedonia/alexandros/gateway"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc/status"
+)
+
+// backendError names the failing service and its gRPC code in the extensions of a GraphQL error.
+func backendError(ctx context.Context, err *gateway.BackendError) *gqlerror.Error {
+	return &gqlerror.Error{
+		Err:     err,
+		Message: err.Error(),
+		Path:    graphql.GetPath(ctx),
+		Extensions: map[string]interface{}{
+			"service":  err.Service,
+			"grpcCode": err.Code().String(),
+		},
+	}
+}
+
+// ErrorPresenter gives every resolver error a stable extensions.code from the hamartia catalogue, derived from the
+// gRPC status the backend answered with, plus the ErrorInfo reason when the service sent one. Errors that already
+// carry a code, like the validation errors of gqlgen itself, are left alone.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if gqlErr.Err == nil {
+		return gqlErr
+	}
+	if _, ok := gqlErr.Extensions["code"]; ok {
+		return gqlErr
+	}
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+
+	code := hamartia.CodeInternal
+	if st, ok := status.FromError(gqlErr.Err); ok {
+		code = hamartia.CodeFor(st.Code())
+		// a bare status reads "rpc error: code = ... desc = ...", the description is all a client needs
+		if gqlErr.Message == gqlErr.Err.Error() {
+			gqlErr.Message = st.Message()
+		}
+	} else if errors.Is(gqlErr.Err, context.DeadlineExceeded) {
+		code = hamartia.CodeTimeout
+	}
+	gqlErr.Extensions["code"] = code
+
+	if reason, metadata := hamartia.Reason(gqlErr.Err); reason != "" {
+		gqlErr.Extensions["reason"] = reason
+		if len(metadata) > 0 {
+			gqlErr.Extensions["metadata"] = metadata
+		}
+	}
+
+	return gqlErr
+}
@@ -0,0 +1,259 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/odysseia-greek/agora/hesiodos"
+	"github.com/odysseia-greek/makedonia/alexandros/gateway"
+	antigonosv1 "github.com/odysseia-greek/makedonia/antigonos/gen/go/v1"
+	"github.com/odysseia-greek/makedonia/antigonos/monophthalmus"
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	hefaistionv1 "github.com/odysseia-greek/makedonia/hefaistion/gen/go/v1"
+	"github.com/odysseia-greek/makedonia/hefaistion/philia"
+	"github.com/odysseia-greek/makedonia/ptolemaios/aigyptos"
+	ptolemaiosv1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// backendFake answers like one of the three services an expanded exact search calls. A set err is returned instead
+// of a result, hang blocks until the deadline of the call passes.
+type backendFake struct {
+	err  error
+	hang bool
+}
+
+func (f backendFake) answer(ctx context.Context) error {
+	if f.hang {
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return f.err
+}
+
+type fakeHefaistion struct {
+	hefaistionv1.UnimplementedHefastionServiceServer
+	backendFake
+}
+
+func (f *fakeHefaistion) Search(ctx context.Context, request *koinos.SearchQuery) (*hefaistionv1.SearchResponse, error) {
+	if err := f.answer(ctx); err != nil {
+		return nil, err
+	}
+	return &hefaistionv1.SearchResponse{
+		Results:  []*koinos.Lemma{{Headword: request.Word}},
+		PageInfo: &koinos.PageInfo{Page: 1, Size: 1, Total: 1},
+	}, nil
+}
+
+type fakeAntigonos struct {
+	antigonosv1.UnimplementedAntigonosServiceServer
+	backendFake
+}
+
+func (f *fakeAntigonos) Search(ctx context.Context, request *koinos.SearchQuery) (*antigonosv1.SearchResponse, error) {
+	if err := f.answer(ctx); err != nil {
+		return nil, err
+	}
+	return &antigonosv1.SearchResponse{
+		Results:  []*koinos.Lemma{{Headword: request.Word}, {Headword: "λόγιος"}},
+		PageInfo: &koinos.PageInfo{Page: 1, Size: 2, Total: 2},
+	}, nil
+}
+
+type fakePtolemaios struct {
+	ptolemaiosv1.UnimplementedPtolemaiosServiceServer
+	backendFake
+}
+
+func (f *fakePtolemaios) Search(ctx context.Context, request *ptolemaiosv1.ExtendedSearch) (*ptolemaiosv1.ExtendedSearchResponse, error) {
+	if err := f.answer(ctx); err != nil {
+		return nil, err
+	}
+	return &ptolemaiosv1.ExtendedSearchResponse{
+		FoundInText: &ptolemaiosv1.AnalyzeTextResponse{Rootword: request.Word},
+	}, nil
+}
+
+// serve starts a gRPC server on a free local port and returns its address.
+func serve(t *testing.T, register func(*grpc.Server)) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func dial[T any](t *testing.T, address string, dialFn func(string) (T, error)) *hesiodos.GenericGrpcClient[T] {
+	t.Helper()
+	client, err := hesiodos.NewGenericGrpcClient(address, dialFn)
+	assert.Nil(t, err)
+	return client
+}
+
+type expandFakes struct {
+	hefaistion *fakeHefaistion
+	antigonos  *fakeAntigonos
+	ptolemaios *fakePtolemaios
+}
+
+// fakeHandler dials the fakes, with short deadlines so a hanging fake fails an expanded search quickly.
+func fakeHandler(t *testing.T, fakes expandFakes) *gateway.AlexandrosHandler {
+	exactAddress := serve(t, func(s *grpc.Server) { hefaistionv1.RegisterHefastionServiceServer(s, fakes.hefaistion) })
+	fuzzyAddress := serve(t, func(s *grpc.Server) { antigonosv1.RegisterAntigonosServiceServer(s, fakes.antigonos) })
+	extendedAddress := serve(t, func(s *grpc.Server) { ptolemaiosv1.RegisterPtolemaiosServiceServer(s, fakes.ptolemaios) })
+
+	return &gateway.AlexandrosHandler{
+		ExactClient:    dial(t, exactAddress, philia.NewHefaistionClient),
+		FuzzyClient:    dial(t, fuzzyAddress, monophthalmus.NewAntigonosClient),
+		ExtendedClient: dial(t, extendedAddress, aigyptos.NewPtolemaiosClient),
+		ExpandDeadlines: gateway.ExpandDeadlines{
+			Exact:    time.Second,
+			Fuzzy:    time.Second,
+			Extended: 100 * time.Millisecond,
+		},
+	}
+}
+
+// schemaServer serves the schema the way the routes do.
+func schemaServer(alexandrosHandler *gateway.AlexandrosHandler) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{Handler: alexandrosHandler}}))
+	srv.AddTransport(transport.POST{})
+	srv.SetErrorPresenter(ErrorPresenter)
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(gateway.WithLoader(ctx))
+	})
+	return srv
+}
+
+func expandServer(t *testing.T, fakes expandFakes) http.Handler {
+	return schemaServer(fakeHandler(t, fakes))
+}
+
+const expandQuery = `{
+	exact(input: {word: "λόγος", expand: true}) {
+		results { headword }
+		similarWords { greek }
+		foundInText { rootword }
+	}
+}`
+
+type expandResponse struct {
+	Data *struct {
+		Exact *struct {
+			Results      []struct{ Headword string }
+			SimilarWords []struct{ Greek string }
+			FoundInText  *struct{ Rootword string }
+		}
+	}
+	Errors []struct {
+		Message    string
+		Path       []interface{}
+		Extensions map[string]interface{}
+	}
+}
+
+func query(t *testing.T, server http.Handler, document string, response interface{}) {
+	t.Helper()
+	raw, err := json.Marshal(map[string]interface{}{"query": document})
+	assert.Nil(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, "/alexandros/graphql", bytes.NewReader(raw))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Nil(t, json.NewDecoder(recorder.Body).Decode(response))
+}
+
+func queryExpand(t *testing.T, server http.Handler) expandResponse {
+	t.Helper()
+	var response expandResponse
+	query(t, server, expandQuery, &response)
+	return response
+}
+
+func TestExpandedExact(t *testing.T) {
+	healthy := func() expandFakes {
+		return expandFakes{hefaistion: &fakeHefaistion{}, antigonos: &fakeAntigonos{}, ptolemaios: &fakePtolemaios{}}
+	}
+
+	t.Run("AllBackendsAnswer", func(t *testing.T) {
+		response := queryExpand(t, expandServer(t, healthy()))
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, "λόγος", response.Data.Exact.Results[0].Headword)
+		assert.Len(t, response.Data.Exact.SimilarWords, 1)
+		assert.Equal(t, "λόγος", response.Data.Exact.FoundInText.Rootword)
+	})
+
+	t.Run("OneBackendFails", func(t *testing.T) {
+		fakes := healthy()
+		fakes.antigonos.err = status.Error(codes.Internal, "fuzzy index closed")
+
+		response := queryExpand(t, expandServer(t, fakes))
+		// the other backends still answer
+		assert.Equal(t, "λόγος", response.Data.Exact.Results[0].Headword)
+		assert.Equal(t, "λόγος", response.Data.Exact.FoundInText.Rootword)
+		assert.Empty(t, response.Data.Exact.SimilarWords)
+
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, []interface{}{"exact"}, response.Errors[0].Path)
+		assert.Equal(t, "antigonos", response.Errors[0].Extensions["service"])
+		assert.Equal(t, codes.Internal.String(), response.Errors[0].Extensions["grpcCode"])
+	})
+
+	t.Run("OneBackendTimesOut", func(t *testing.T) {
+		fakes := healthy()
+		fakes.ptolemaios.hang = true
+
+		response := queryExpand(t, expandServer(t, fakes))
+		assert.Equal(t, "λόγος", response.Data.Exact.Results[0].Headword)
+		assert.Len(t, response.Data.Exact.SimilarWords, 1)
+		assert.Nil(t, response.Data.Exact.FoundInText)
+
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, "ptolemaios", response.Errors[0].Extensions["service"])
+		assert.Equal(t, codes.DeadlineExceeded.String(), response.Errors[0].Extensions["grpcCode"])
+	})
+
+	t.Run("NoTextsFound", func(t *testing.T) {
+		fakes := healthy()
+		fakes.ptolemaios.err = status.Error(codes.NotFound, "no texts found for λόγος")
+
+		// a word without texts is an empty result, not a failing backend
+		response := queryExpand(t, expandServer(t, fakes))
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, "λόγος", response.Data.Exact.Results[0].Headword)
+		assert.NotNil(t, response.Data.Exact.FoundInText)
+		assert.Empty(t, response.Data.Exact.FoundInText.Rootword)
+	})
+
+	t.Run("ExactFails", func(t *testing.T) {
+		fakes := healthy()
+		fakes.hefaistion.err = status.Error(codes.Unavailable, "no shards")
+
+		// without the dictionary results there is nothing to return
+		response := queryExpand(t, expandServer(t, fakes))
+		assert.Nil(t, response.Data)
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, "hefaistion", response.Errors[0].Extensions["service"])
+		assert.Equal(t, codes.Unavailable.String(), response.Errors[0].Extensions["grpcCode"])
+	})
+}
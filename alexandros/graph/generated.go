@@ -40,6 +40,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -47,7 +48,10 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	AggregatedHealthResponse struct {
+		Cache    func(childComplexity int) int
+		Degraded func(childComplexity int) int
 		Healthy  func(childComplexity int) int
+		Ingester func(childComplexity int) int
 		Services func(childComplexity int) int
 		Time     func(childComplexity int) int
 		Version  func(childComplexity int) int
@@ -67,11 +71,27 @@ type ComplexityRoot struct {
 		Texts        func(childComplexity int) int
 	}
 
+	CacheHealth struct {
+		Backend   func(childComplexity int) int
+		Entries   func(childComplexity int) int
+		Evictions func(childComplexity int) int
+		Hits      func(childComplexity int) int
+		Misses    func(childComplexity int) int
+		Purges    func(childComplexity int) int
+	}
+
 	ConjugationResponse struct {
 		Rule func(childComplexity int) int
 		Word func(childComplexity int) int
 	}
 
+	ConnectionPageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
 	DatabaseInfo struct {
 		ClusterName   func(childComplexity int) int
 		Healthy       func(childComplexity int) int
@@ -84,6 +104,11 @@ type ComplexityRoot struct {
 		Meanings func(childComplexity int) int
 	}
 
+	DictionaryEvent struct {
+		Status func(childComplexity int) int
+		Time   func(childComplexity int) int
+	}
+
 	EukleidesTopFive struct {
 		Count       func(childComplexity int) int
 		LastUsed    func(childComplexity int) int
@@ -95,6 +120,13 @@ type ComplexityRoot struct {
 		TopFive func(childComplexity int) int
 	}
 
+	EukleidesTopPage struct {
+		Entries func(childComplexity int) int
+		Limit   func(childComplexity int) int
+		Offset  func(childComplexity int) int
+		Total   func(childComplexity int) int
+	}
+
 	ExtendedResponse struct {
 		FoundInText  func(childComplexity int) int
 		PageInfo     func(childComplexity int) int
@@ -116,6 +148,14 @@ type ComplexityRoot struct {
 		Original   func(childComplexity int) int
 	}
 
+	IngesterHealth struct {
+		Dropped    func(childComplexity int) int
+		Queued     func(childComplexity int) int
+		Reconnects func(childComplexity int) int
+		Sent       func(childComplexity int) int
+		Spilled    func(childComplexity int) int
+	}
+
 	Lemma struct {
 		Article           func(childComplexity int) int
 		Definitions       func(childComplexity int) int
@@ -131,6 +171,17 @@ type ComplexityRoot struct {
 		Verb              func(childComplexity int) int
 	}
 
+	LemmaConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	LemmaEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	LocalizedGloss struct {
 		Gloss    func(childComplexity int) int
 		Language func(childComplexity int) int
@@ -154,26 +205,38 @@ type ComplexityRoot struct {
 	}
 
 	PageInfo struct {
-		Page  func(childComplexity int) int
-		Size  func(childComplexity int) int
-		Total func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+		NextCursor  func(childComplexity int) int
+		Page        func(childComplexity int) int
+		Size        func(childComplexity int) int
+		Total       func(childComplexity int) int
 	}
 
 	Query struct {
-		CounterService func(childComplexity int, name string) int
-		CounterSession func(childComplexity int, sessionID string) int
-		CounterTopFive func(childComplexity int) int
-		Exact          func(childComplexity int, input model.ExpandableSearchQueryInput) int
-		Fuzzy          func(childComplexity int, input model.SearchQueryInput) int
-		Health         func(childComplexity int) int
-		Partial        func(childComplexity int, input model.SearchQueryInput) int
-		Phrase         func(childComplexity int, input model.SearchQueryInput) int
-		Text           func(childComplexity int, input model.ExpandableSearchQueryInput) int
-	}
-
-	Reference struct {
-		Locus func(childComplexity int) int
-		Work  func(childComplexity int) int
+		CounterService     func(childComplexity int, name string, window *model.CounterWindow) int
+		CounterSession     func(childComplexity int, sessionID string) int
+		CounterTop         func(childComplexity int, input *model.CounterTopInput) int
+		CounterTopFive     func(childComplexity int, window *model.CounterWindow) int
+		CounterZeroResults func(childComplexity int, input *model.ZeroResultsInput) int
+		Exact              func(childComplexity int, input model.ExpandableSearchQueryInput) int
+		ExactConnection    func(childComplexity int, word string, language *model.Language, first *int32, after *string) int
+		Fuzzy              func(childComplexity int, input model.SearchQueryInput) int
+		FuzzyConnection    func(childComplexity int, word string, language *model.Language, first *int32, after *string) int
+		Health             func(childComplexity int) int
+		Partial            func(childComplexity int, input model.SearchQueryInput) int
+		PartialConnection  func(childComplexity int, word string, language *model.Language, first *int32, after *string) int
+		Phrase             func(childComplexity int, input model.SearchQueryInput) int
+		PhraseConnection   func(childComplexity int, word string, language *model.Language, first *int32, after *string) int
+		Search             func(childComplexity int, input model.UnifiedSearchInput) int
+		Text               func(childComplexity int, input model.ExpandableSearchQueryInput) int
+		Trending           func(childComplexity int, window *model.CounterWindow, serviceName *string, limit *int32, minCount *int32) int
+	}
+
+	RankedLemma struct {
+		FoundBy  func(childComplexity int) int
+		Lemma    func(childComplexity int) int
+		Rank     func(childComplexity int) int
+		Strategy func(childComplexity int) int
 	}
 
 	Rhema struct {
@@ -182,18 +245,57 @@ type ComplexityRoot struct {
 		Translations func(childComplexity int) int
 	}
 
+	SearchEvent struct {
+		Language    func(childComplexity int) int
+		ResultCount func(childComplexity int) int
+		SearchType  func(childComplexity int) int
+		ServiceName func(childComplexity int) int
+		Time        func(childComplexity int) int
+		Word        func(childComplexity int) int
+	}
+
 	SearchResponse struct {
 		PageInfo func(childComplexity int) int
 		Results  func(childComplexity int) int
 	}
 
 	ServiceHealth struct {
+		Critical     func(childComplexity int) int
 		DatabaseInfo func(childComplexity int) int
+		Error        func(childComplexity int) int
 		Healthy      func(childComplexity int) int
+		LatencyMs    func(childComplexity int) int
 		Name         func(childComplexity int) int
 		Version      func(childComplexity int) int
 	}
 
+	StrategyTiming struct {
+		DurationMs func(childComplexity int) int
+		Error      func(childComplexity int) int
+		Results    func(childComplexity int) int
+		Strategy   func(childComplexity int) int
+		Total      func(childComplexity int) int
+	}
+
+	Subscription struct {
+		Dictionary func(childComplexity int) int
+		Searches   func(childComplexity int, serviceName *string, sessionID *string) int
+	}
+
+	TrendingWord struct {
+		BaselineAverage func(childComplexity int) int
+		LastUsed        func(childComplexity int) int
+		RecentCount     func(childComplexity int) int
+		Score           func(childComplexity int) int
+		ServiceName     func(childComplexity int) int
+		Word            func(childComplexity int) int
+	}
+
+	UnifiedSearchResponse struct {
+		Results func(childComplexity int) int
+		Timings func(childComplexity int) int
+	}
+
 	VerbInfo struct {
 		PrincipalParts func(childComplexity int) int
 	}
@@ -201,14 +303,26 @@ type ComplexityRoot struct {
 
 type QueryResolver interface {
 	Health(ctx context.Context) (*model.AggregatedHealthResponse, error)
-	CounterTopFive(ctx context.Context) (*model.EukleidesTopFiveResponse, error)
-	CounterService(ctx context.Context, name string) (*model.EukleidesTopFive, error)
+	CounterTopFive(ctx context.Context, window *model.CounterWindow) (*model.EukleidesTopFiveResponse, error)
+	CounterService(ctx context.Context, name string, window *model.CounterWindow) (*model.EukleidesTopFive, error)
 	CounterSession(ctx context.Context, sessionID string) (*model.EukleidesTopFiveResponse, error)
+	CounterTop(ctx context.Context, input *model.CounterTopInput) (*model.EukleidesTopPage, error)
+	CounterZeroResults(ctx context.Context, input *model.ZeroResultsInput) (*model.EukleidesTopPage, error)
+	Trending(ctx context.Context, window *model.CounterWindow, serviceName *string, limit *int32, minCount *int32) ([]*model.TrendingWord, error)
 	Text(ctx context.Context, input model.ExpandableSearchQueryInput) (*model.ExtendedResponse, error)
 	Fuzzy(ctx context.Context, input model.SearchQueryInput) (*model.SearchResponse, error)
 	Exact(ctx context.Context, input model.ExpandableSearchQueryInput) (*model.ExtendedResponse, error)
 	Phrase(ctx context.Context, input model.SearchQueryInput) (*model.SearchResponse, error)
 	Partial(ctx context.Context, input model.SearchQueryInput) (*model.SearchResponse, error)
+	Search(ctx context.Context, input model.UnifiedSearchInput) (*model.UnifiedSearchResponse, error)
+	ExactConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error)
+	FuzzyConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error)
+	PhraseConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error)
+	PartialConnection(ctx context.Context, word string, language *model.Language, first *int32, after *string) (*model.LemmaConnection, error)
+}
+type SubscriptionResolver interface {
+	Searches(ctx context.Context, serviceName *string, sessionID *string) (<-chan *model.SearchEvent, error)
+	Dictionary(ctx context.Context) (<-chan *model.DictionaryEvent, error)
 }
 
 type executableSchema struct {
@@ -230,12 +344,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AggregatedHealthResponse.cache":
+		if e.complexity.AggregatedHealthResponse.Cache == nil {
+			break
+		}
+
+		return e.complexity.AggregatedHealthResponse.Cache(childComplexity), true
+	case "AggregatedHealthResponse.degraded":
+		if e.complexity.AggregatedHealthResponse.Degraded == nil {
+			break
+		}
+
+		return e.complexity.AggregatedHealthResponse.Degraded(childComplexity), true
 	case "AggregatedHealthResponse.healthy":
 		if e.complexity.AggregatedHealthResponse.Healthy == nil {
 			break
 		}
 
 		return e.complexity.AggregatedHealthResponse.Healthy(childComplexity), true
+	case "AggregatedHealthResponse.ingester":
+		if e.complexity.AggregatedHealthResponse.Ingester == nil {
+			break
+		}
+
+		return e.complexity.AggregatedHealthResponse.Ingester(childComplexity), true
 	case "AggregatedHealthResponse.services":
 		if e.complexity.AggregatedHealthResponse.Services == nil {
 			break
@@ -305,6 +437,43 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AnalyzeTextResponse.Texts(childComplexity), true
 
+	case "CacheHealth.backend":
+		if e.complexity.CacheHealth.Backend == nil {
+			break
+		}
+
+		return e.complexity.CacheHealth.Backend(childComplexity), true
+	case "CacheHealth.entries":
+		if e.complexity.CacheHealth.Entries == nil {
+			break
+		}
+
+		return e.complexity.CacheHealth.Entries(childComplexity), true
+	case "CacheHealth.evictions":
+		if e.complexity.CacheHealth.Evictions == nil {
+			break
+		}
+
+		return e.complexity.CacheHealth.Evictions(childComplexity), true
+	case "CacheHealth.hits":
+		if e.complexity.CacheHealth.Hits == nil {
+			break
+		}
+
+		return e.complexity.CacheHealth.Hits(childComplexity), true
+	case "CacheHealth.misses":
+		if e.complexity.CacheHealth.Misses == nil {
+			break
+		}
+
+		return e.complexity.CacheHealth.Misses(childComplexity), true
+	case "CacheHealth.purges":
+		if e.complexity.CacheHealth.Purges == nil {
+			break
+		}
+
+		return e.complexity.CacheHealth.Purges(childComplexity), true
+
 	case "ConjugationResponse.rule":
 		if e.complexity.ConjugationResponse.Rule == nil {
 			break
@@ -318,6 +487,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ConjugationResponse.Word(childComplexity), true
 
+	case "ConnectionPageInfo.endCursor":
+		if e.complexity.ConnectionPageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.ConnectionPageInfo.EndCursor(childComplexity), true
+	case "ConnectionPageInfo.hasNextPage":
+		if e.complexity.ConnectionPageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.ConnectionPageInfo.HasNextPage(childComplexity), true
+	case "ConnectionPageInfo.hasPreviousPage":
+		if e.complexity.ConnectionPageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.ConnectionPageInfo.HasPreviousPage(childComplexity), true
+	case "ConnectionPageInfo.startCursor":
+		if e.complexity.ConnectionPageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.ConnectionPageInfo.StartCursor(childComplexity), true
+
 	case "DatabaseInfo.clusterName":
 		if e.complexity.DatabaseInfo.ClusterName == nil {
 			break
@@ -356,6 +550,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Definition.Meanings(childComplexity), true
 
+	case "DictionaryEvent.status":
+		if e.complexity.DictionaryEvent.Status == nil {
+			break
+		}
+
+		return e.complexity.DictionaryEvent.Status(childComplexity), true
+	case "DictionaryEvent.time":
+		if e.complexity.DictionaryEvent.Time == nil {
+			break
+		}
+
+		return e.complexity.DictionaryEvent.Time(childComplexity), true
+
 	case "EukleidesTopFive.count":
 		if e.complexity.EukleidesTopFive.Count == nil {
 			break
@@ -388,6 +595,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.EukleidesTopFiveResponse.TopFive(childComplexity), true
 
+	case "EukleidesTopPage.entries":
+		if e.complexity.EukleidesTopPage.Entries == nil {
+			break
+		}
+
+		return e.complexity.EukleidesTopPage.Entries(childComplexity), true
+	case "EukleidesTopPage.limit":
+		if e.complexity.EukleidesTopPage.Limit == nil {
+			break
+		}
+
+		return e.complexity.EukleidesTopPage.Limit(childComplexity), true
+	case "EukleidesTopPage.offset":
+		if e.complexity.EukleidesTopPage.Offset == nil {
+			break
+		}
+
+		return e.complexity.EukleidesTopPage.Offset(childComplexity), true
+	case "EukleidesTopPage.total":
+		if e.complexity.EukleidesTopPage.Total == nil {
+			break
+		}
+
+		return e.complexity.EukleidesTopPage.Total(childComplexity), true
+
 	case "ExtendedResponse.foundInText":
 		if e.complexity.ExtendedResponse.FoundInText == nil {
 			break
@@ -463,6 +695,37 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Hit.Original(childComplexity), true
 
+	case "IngesterHealth.dropped":
+		if e.complexity.IngesterHealth.Dropped == nil {
+			break
+		}
+
+		return e.complexity.IngesterHealth.Dropped(childComplexity), true
+	case "IngesterHealth.queued":
+		if e.complexity.IngesterHealth.Queued == nil {
+			break
+		}
+
+		return e.complexity.IngesterHealth.Queued(childComplexity), true
+	case "IngesterHealth.reconnects":
+		if e.complexity.IngesterHealth.Reconnects == nil {
+			break
+		}
+
+		return e.complexity.IngesterHealth.Reconnects(childComplexity), true
+	case "IngesterHealth.sent":
+		if e.complexity.IngesterHealth.Sent == nil {
+			break
+		}
+
+		return e.complexity.IngesterHealth.Sent(childComplexity), true
+	case "IngesterHealth.spilled":
+		if e.complexity.IngesterHealth.Spilled == nil {
+			break
+		}
+
+		return e.complexity.IngesterHealth.Spilled(childComplexity), true
+
 	case "Lemma.article":
 		if e.complexity.Lemma.Article == nil {
 			break
@@ -536,6 +799,38 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Lemma.Verb(childComplexity), true
 
+	case "LemmaConnection.edges":
+		if e.complexity.LemmaConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.LemmaConnection.Edges(childComplexity), true
+	case "LemmaConnection.pageInfo":
+		if e.complexity.LemmaConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.LemmaConnection.PageInfo(childComplexity), true
+	case "LemmaConnection.totalCount":
+		if e.complexity.LemmaConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.LemmaConnection.TotalCount(childComplexity), true
+
+	case "LemmaEdge.cursor":
+		if e.complexity.LemmaEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.LemmaEdge.Cursor(childComplexity), true
+	case "LemmaEdge.node":
+		if e.complexity.LemmaEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.LemmaEdge.Node(childComplexity), true
+
 	case "LocalizedGloss.gloss":
 		if e.complexity.LocalizedGloss.Gloss == nil {
 			break
@@ -600,6 +895,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.NounInfo.Genitive(childComplexity), true
 
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+	case "PageInfo.nextCursor":
+		if e.complexity.PageInfo.NextCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.NextCursor(childComplexity), true
 	case "PageInfo.page":
 		if e.complexity.PageInfo.Page == nil {
 			break
@@ -629,7 +936,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Query.CounterService(childComplexity, args["name"].(string)), true
+		return e.complexity.Query.CounterService(childComplexity, args["name"].(string), args["window"].(*model.CounterWindow)), true
 	case "Query.counterSession":
 		if e.complexity.Query.CounterSession == nil {
 			break
@@ -641,12 +948,39 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.CounterSession(childComplexity, args["sessionId"].(string)), true
+	case "Query.counterTop":
+		if e.complexity.Query.CounterTop == nil {
+			break
+		}
+
+		args, err := ec.field_Query_counterTop_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CounterTop(childComplexity, args["input"].(*model.CounterTopInput)), true
 	case "Query.counterTopFive":
 		if e.complexity.Query.CounterTopFive == nil {
 			break
 		}
 
-		return e.complexity.Query.CounterTopFive(childComplexity), true
+		args, err := ec.field_Query_counterTopFive_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CounterTopFive(childComplexity, args["window"].(*model.CounterWindow)), true
+	case "Query.counterZeroResults":
+		if e.complexity.Query.CounterZeroResults == nil {
+			break
+		}
+
+		args, err := ec.field_Query_counterZeroResults_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CounterZeroResults(childComplexity, args["input"].(*model.ZeroResultsInput)), true
 	case "Query.exact":
 		if e.complexity.Query.Exact == nil {
 			break
@@ -658,6 +992,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Exact(childComplexity, args["input"].(model.ExpandableSearchQueryInput)), true
+	case "Query.exactConnection":
+		if e.complexity.Query.ExactConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_exactConnection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ExactConnection(childComplexity, args["word"].(string), args["language"].(*model.Language), args["first"].(*int32), args["after"].(*string)), true
 	case "Query.fuzzy":
 		if e.complexity.Query.Fuzzy == nil {
 			break
@@ -669,6 +1014,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Fuzzy(childComplexity, args["input"].(model.SearchQueryInput)), true
+	case "Query.fuzzyConnection":
+		if e.complexity.Query.FuzzyConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_fuzzyConnection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FuzzyConnection(childComplexity, args["word"].(string), args["language"].(*model.Language), args["first"].(*int32), args["after"].(*string)), true
 	case "Query.health":
 		if e.complexity.Query.Health == nil {
 			break
@@ -686,6 +1042,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Partial(childComplexity, args["input"].(model.SearchQueryInput)), true
+	case "Query.partialConnection":
+		if e.complexity.Query.PartialConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_partialConnection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PartialConnection(childComplexity, args["word"].(string), args["language"].(*model.Language), args["first"].(*int32), args["after"].(*string)), true
 	case "Query.phrase":
 		if e.complexity.Query.Phrase == nil {
 			break
@@ -697,6 +1064,28 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Phrase(childComplexity, args["input"].(model.SearchQueryInput)), true
+	case "Query.phraseConnection":
+		if e.complexity.Query.PhraseConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_phraseConnection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PhraseConnection(childComplexity, args["word"].(string), args["language"].(*model.Language), args["first"].(*int32), args["after"].(*string)), true
+	case "Query.search":
+		if e.complexity.Query.Search == nil {
+			break
+		}
+
+		args, err := ec.field_Query_search_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Search(childComplexity, args["input"].(model.UnifiedSearchInput)), true
 	case "Query.text":
 		if e.complexity.Query.Text == nil {
 			break
@@ -708,19 +1097,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Text(childComplexity, args["input"].(model.ExpandableSearchQueryInput)), true
+	case "Query.trending":
+		if e.complexity.Query.Trending == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trending_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Trending(childComplexity, args["window"].(*model.CounterWindow), args["serviceName"].(*string), args["limit"].(*int32), args["minCount"].(*int32)), true
+
+	case "RankedLemma.foundBy":
+		if e.complexity.RankedLemma.FoundBy == nil {
+			break
+		}
 
-	case "Reference.locus":
-		if e.complexity.Reference.Locus == nil {
+		return e.complexity.RankedLemma.FoundBy(childComplexity), true
+	case "RankedLemma.lemma":
+		if e.complexity.RankedLemma.Lemma == nil {
 			break
 		}
 
-		return e.complexity.Reference.Locus(childComplexity), true
-	case "Reference.work":
-		if e.complexity.Reference.Work == nil {
+		return e.complexity.RankedLemma.Lemma(childComplexity), true
+	case "RankedLemma.rank":
+		if e.complexity.RankedLemma.Rank == nil {
 			break
 		}
 
-		return e.complexity.Reference.Work(childComplexity), true
+		return e.complexity.RankedLemma.Rank(childComplexity), true
+	case "RankedLemma.strategy":
+		if e.complexity.RankedLemma.Strategy == nil {
+			break
+		}
+
+		return e.complexity.RankedLemma.Strategy(childComplexity), true
 
 	case "Rhema.greek":
 		if e.complexity.Rhema.Greek == nil {
@@ -741,6 +1153,43 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Rhema.Translations(childComplexity), true
 
+	case "SearchEvent.language":
+		if e.complexity.SearchEvent.Language == nil {
+			break
+		}
+
+		return e.complexity.SearchEvent.Language(childComplexity), true
+	case "SearchEvent.resultCount":
+		if e.complexity.SearchEvent.ResultCount == nil {
+			break
+		}
+
+		return e.complexity.SearchEvent.ResultCount(childComplexity), true
+	case "SearchEvent.searchType":
+		if e.complexity.SearchEvent.SearchType == nil {
+			break
+		}
+
+		return e.complexity.SearchEvent.SearchType(childComplexity), true
+	case "SearchEvent.serviceName":
+		if e.complexity.SearchEvent.ServiceName == nil {
+			break
+		}
+
+		return e.complexity.SearchEvent.ServiceName(childComplexity), true
+	case "SearchEvent.time":
+		if e.complexity.SearchEvent.Time == nil {
+			break
+		}
+
+		return e.complexity.SearchEvent.Time(childComplexity), true
+	case "SearchEvent.word":
+		if e.complexity.SearchEvent.Word == nil {
+			break
+		}
+
+		return e.complexity.SearchEvent.Word(childComplexity), true
+
 	case "SearchResponse.pageInfo":
 		if e.complexity.SearchResponse.PageInfo == nil {
 			break
@@ -754,18 +1203,36 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SearchResponse.Results(childComplexity), true
 
+	case "ServiceHealth.critical":
+		if e.complexity.ServiceHealth.Critical == nil {
+			break
+		}
+
+		return e.complexity.ServiceHealth.Critical(childComplexity), true
 	case "ServiceHealth.databaseInfo":
 		if e.complexity.ServiceHealth.DatabaseInfo == nil {
 			break
 		}
 
 		return e.complexity.ServiceHealth.DatabaseInfo(childComplexity), true
+	case "ServiceHealth.error":
+		if e.complexity.ServiceHealth.Error == nil {
+			break
+		}
+
+		return e.complexity.ServiceHealth.Error(childComplexity), true
 	case "ServiceHealth.healthy":
 		if e.complexity.ServiceHealth.Healthy == nil {
 			break
 		}
 
 		return e.complexity.ServiceHealth.Healthy(childComplexity), true
+	case "ServiceHealth.latencyMs":
+		if e.complexity.ServiceHealth.LatencyMs == nil {
+			break
+		}
+
+		return e.complexity.ServiceHealth.LatencyMs(childComplexity), true
 	case "ServiceHealth.name":
 		if e.complexity.ServiceHealth.Name == nil {
 			break
@@ -779,39 +1246,141 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ServiceHealth.Version(childComplexity), true
 
-	case "VerbInfo.principalParts":
-		if e.complexity.VerbInfo.PrincipalParts == nil {
+	case "StrategyTiming.durationMs":
+		if e.complexity.StrategyTiming.DurationMs == nil {
 			break
 		}
 
-		return e.complexity.VerbInfo.PrincipalParts(childComplexity), true
+		return e.complexity.StrategyTiming.DurationMs(childComplexity), true
+	case "StrategyTiming.error":
+		if e.complexity.StrategyTiming.Error == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		return e.complexity.StrategyTiming.Error(childComplexity), true
+	case "StrategyTiming.results":
+		if e.complexity.StrategyTiming.Results == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputExpandableSearchQueryInput,
-		ec.unmarshalInputSearchQueryInput,
-	)
-	first := true
+		return e.complexity.StrategyTiming.Results(childComplexity), true
+	case "StrategyTiming.strategy":
+		if e.complexity.StrategyTiming.Strategy == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
+		return e.complexity.StrategyTiming.Strategy(childComplexity), true
+	case "StrategyTiming.total":
+		if e.complexity.StrategyTiming.Total == nil {
+			break
+		}
+
+		return e.complexity.StrategyTiming.Total(childComplexity), true
+
+	case "Subscription.dictionary":
+		if e.complexity.Subscription.Dictionary == nil {
+			break
+		}
+
+		return e.complexity.Subscription.Dictionary(childComplexity), true
+	case "Subscription.searches":
+		if e.complexity.Subscription.Searches == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_searches_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.Searches(childComplexity, args["serviceName"].(*string), args["sessionId"].(*string)), true
+
+	case "TrendingWord.baselineAverage":
+		if e.complexity.TrendingWord.BaselineAverage == nil {
+			break
+		}
+
+		return e.complexity.TrendingWord.BaselineAverage(childComplexity), true
+	case "TrendingWord.lastUsed":
+		if e.complexity.TrendingWord.LastUsed == nil {
+			break
+		}
+
+		return e.complexity.TrendingWord.LastUsed(childComplexity), true
+	case "TrendingWord.recentCount":
+		if e.complexity.TrendingWord.RecentCount == nil {
+			break
+		}
+
+		return e.complexity.TrendingWord.RecentCount(childComplexity), true
+	case "TrendingWord.score":
+		if e.complexity.TrendingWord.Score == nil {
+			break
+		}
+
+		return e.complexity.TrendingWord.Score(childComplexity), true
+	case "TrendingWord.serviceName":
+		if e.complexity.TrendingWord.ServiceName == nil {
+			break
+		}
+
+		return e.complexity.TrendingWord.ServiceName(childComplexity), true
+	case "TrendingWord.word":
+		if e.complexity.TrendingWord.Word == nil {
+			break
+		}
+
+		return e.complexity.TrendingWord.Word(childComplexity), true
+
+	case "UnifiedSearchResponse.results":
+		if e.complexity.UnifiedSearchResponse.Results == nil {
+			break
+		}
+
+		return e.complexity.UnifiedSearchResponse.Results(childComplexity), true
+	case "UnifiedSearchResponse.timings":
+		if e.complexity.UnifiedSearchResponse.Timings == nil {
+			break
+		}
+
+		return e.complexity.UnifiedSearchResponse.Timings(childComplexity), true
+
+	case "VerbInfo.principalParts":
+		if e.complexity.VerbInfo.PrincipalParts == nil {
+			break
+		}
+
+		return e.complexity.VerbInfo.PrincipalParts(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCounterTopInput,
+		ec.unmarshalInputExpandableSearchQueryInput,
+		ec.unmarshalInputSearchQueryInput,
+		ec.unmarshalInputUnifiedSearchInput,
+		ec.unmarshalInputZeroResultsInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
 					data = result.Result
 					response.Path = result.Path
 					response.Label = result.Label
@@ -830,6 +1399,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 
 			return &response
 		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
 
 	default:
 		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
@@ -917,6 +1503,11 @@ func (ec *executionContext) field_Query_counterService_args(ctx context.Context,
 		return nil, err
 	}
 	args["name"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "window", ec.unmarshalOCounterWindow2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterWindow)
+	if err != nil {
+		return nil, err
+	}
+	args["window"] = arg1
 	return args, nil
 }
 
@@ -931,6 +1522,65 @@ func (ec *executionContext) field_Query_counterSession_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_counterTopFive_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "window", ec.unmarshalOCounterWindow2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterWindow)
+	if err != nil {
+		return nil, err
+	}
+	args["window"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_counterTop_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalOCounterTopInput2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterTopInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_counterZeroResults_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalOZeroResultsInput2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐZeroResultsInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_exactConnection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "word", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["word"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "language", ec.unmarshalOLanguage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLanguage)
+	if err != nil {
+		return nil, err
+	}
+	args["language"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_exact_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -942,6 +1592,32 @@ func (ec *executionContext) field_Query_exact_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_fuzzyConnection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "word", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["word"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "language", ec.unmarshalOLanguage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLanguage)
+	if err != nil {
+		return nil, err
+	}
+	args["language"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_fuzzy_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -953,6 +1629,32 @@ func (ec *executionContext) field_Query_fuzzy_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_partialConnection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "word", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["word"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "language", ec.unmarshalOLanguage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLanguage)
+	if err != nil {
+		return nil, err
+	}
+	args["language"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_partial_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -964,6 +1666,32 @@ func (ec *executionContext) field_Query_partial_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_phraseConnection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "word", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["word"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "language", ec.unmarshalOLanguage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLanguage)
+	if err != nil {
+		return nil, err
+	}
+	args["language"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_phrase_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -975,6 +1703,17 @@ func (ec *executionContext) field_Query_phrase_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_search_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUnifiedSearchInput2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐUnifiedSearchInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_text_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -986,6 +1725,48 @@ func (ec *executionContext) field_Query_text_args(ctx context.Context, rawArgs m
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_trending_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "window", ec.unmarshalOCounterWindow2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterWindow)
+	if err != nil {
+		return nil, err
+	}
+	args["window"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "serviceName", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["serviceName"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "minCount", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["minCount"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_searches_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "serviceName", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["serviceName"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "sessionId", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["sessionId"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1067,6 +1848,35 @@ func (ec *executionContext) fieldContext_AggregatedHealthResponse_healthy(_ cont
 	return fc, nil
 }
 
+func (ec *executionContext) _AggregatedHealthResponse_degraded(ctx context.Context, field graphql.CollectedField, obj *model.AggregatedHealthResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AggregatedHealthResponse_degraded,
+		func(ctx context.Context) (any, error) {
+			return obj.Degraded, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_AggregatedHealthResponse_degraded(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AggregatedHealthResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AggregatedHealthResponse_time(ctx context.Context, field graphql.CollectedField, obj *model.AggregatedHealthResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1153,6 +1963,12 @@ func (ec *executionContext) fieldContext_AggregatedHealthResponse_services(_ con
 				return ec.fieldContext_ServiceHealth_name(ctx, field)
 			case "healthy":
 				return ec.fieldContext_ServiceHealth_healthy(ctx, field)
+			case "critical":
+				return ec.fieldContext_ServiceHealth_critical(ctx, field)
+			case "latencyMs":
+				return ec.fieldContext_ServiceHealth_latencyMs(ctx, field)
+			case "error":
+				return ec.fieldContext_ServiceHealth_error(ctx, field)
 			case "version":
 				return ec.fieldContext_ServiceHealth_version(ctx, field)
 			case "databaseInfo":
@@ -1164,54 +1980,138 @@ func (ec *executionContext) fieldContext_AggregatedHealthResponse_services(_ con
 	return fc, nil
 }
 
-func (ec *executionContext) _AnalyzeResult_author(ctx context.Context, field graphql.CollectedField, obj *model.AnalyzeResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AggregatedHealthResponse_cache(ctx context.Context, field graphql.CollectedField, obj *model.AggregatedHealthResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AnalyzeResult_author,
+		ec.fieldContext_AggregatedHealthResponse_cache,
 		func(ctx context.Context) (any, error) {
-			return obj.Author, nil
+			return obj.Cache, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOCacheHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCacheHealth,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AnalyzeResult_author(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AggregatedHealthResponse_cache(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AnalyzeResult",
+		Object:     "AggregatedHealthResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "backend":
+				return ec.fieldContext_CacheHealth_backend(ctx, field)
+			case "entries":
+				return ec.fieldContext_CacheHealth_entries(ctx, field)
+			case "hits":
+				return ec.fieldContext_CacheHealth_hits(ctx, field)
+			case "misses":
+				return ec.fieldContext_CacheHealth_misses(ctx, field)
+			case "evictions":
+				return ec.fieldContext_CacheHealth_evictions(ctx, field)
+			case "purges":
+				return ec.fieldContext_CacheHealth_purges(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CacheHealth", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AnalyzeResult_book(ctx context.Context, field graphql.CollectedField, obj *model.AnalyzeResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _AggregatedHealthResponse_ingester(ctx context.Context, field graphql.CollectedField, obj *model.AggregatedHealthResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_AnalyzeResult_book,
+		ec.fieldContext_AggregatedHealthResponse_ingester,
 		func(ctx context.Context) (any, error) {
-			return obj.Book, nil
+			return obj.Ingester, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOIngesterHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐIngesterHealth,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_AnalyzeResult_book(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AggregatedHealthResponse_ingester(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AnalyzeResult",
+		Object:     "AggregatedHealthResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sent":
+				return ec.fieldContext_IngesterHealth_sent(ctx, field)
+			case "dropped":
+				return ec.fieldContext_IngesterHealth_dropped(ctx, field)
+			case "spilled":
+				return ec.fieldContext_IngesterHealth_spilled(ctx, field)
+			case "reconnects":
+				return ec.fieldContext_IngesterHealth_reconnects(ctx, field)
+			case "queued":
+				return ec.fieldContext_IngesterHealth_queued(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IngesterHealth", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AnalyzeResult_author(ctx context.Context, field graphql.CollectedField, obj *model.AnalyzeResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AnalyzeResult_author,
+		func(ctx context.Context) (any, error) {
+			return obj.Author, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AnalyzeResult_author(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AnalyzeResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AnalyzeResult_book(ctx context.Context, field graphql.CollectedField, obj *model.AnalyzeResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_AnalyzeResult_book,
+		func(ctx context.Context) (any, error) {
+			return obj.Book, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_AnalyzeResult_book(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AnalyzeResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1422,25 +2322,25 @@ func (ec *executionContext) fieldContext_AnalyzeTextResponse_rootword(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _ConjugationResponse_rule(ctx context.Context, field graphql.CollectedField, obj *model.ConjugationResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CacheHealth_backend(ctx context.Context, field graphql.CollectedField, obj *model.CacheHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ConjugationResponse_rule,
+		ec.fieldContext_CacheHealth_backend,
 		func(ctx context.Context) (any, error) {
-			return obj.Rule, nil
+			return obj.Backend, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ConjugationResponse_rule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CacheHealth_backend(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConjugationResponse",
+		Object:     "CacheHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1451,285 +2351,275 @@ func (ec *executionContext) fieldContext_ConjugationResponse_rule(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ConjugationResponse_word(ctx context.Context, field graphql.CollectedField, obj *model.ConjugationResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CacheHealth_entries(ctx context.Context, field graphql.CollectedField, obj *model.CacheHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ConjugationResponse_word,
+		ec.fieldContext_CacheHealth_entries,
 		func(ctx context.Context) (any, error) {
-			return obj.Word, nil
+			return obj.Entries, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ConjugationResponse_word(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CacheHealth_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConjugationResponse",
+		Object:     "CacheHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DatabaseInfo_healthy(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _CacheHealth_hits(ctx context.Context, field graphql.CollectedField, obj *model.CacheHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DatabaseInfo_healthy,
+		ec.fieldContext_CacheHealth_hits,
 		func(ctx context.Context) (any, error) {
-			return obj.Healthy, nil
+			return obj.Hits, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DatabaseInfo_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CacheHealth_hits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DatabaseInfo",
+		Object:     "CacheHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DatabaseInfo_clusterName(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _CacheHealth_misses(ctx context.Context, field graphql.CollectedField, obj *model.CacheHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DatabaseInfo_clusterName,
+		ec.fieldContext_CacheHealth_misses,
 		func(ctx context.Context) (any, error) {
-			return obj.ClusterName, nil
+			return obj.Misses, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DatabaseInfo_clusterName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CacheHealth_misses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DatabaseInfo",
+		Object:     "CacheHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DatabaseInfo_serverName(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _CacheHealth_evictions(ctx context.Context, field graphql.CollectedField, obj *model.CacheHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DatabaseInfo_serverName,
+		ec.fieldContext_CacheHealth_evictions,
 		func(ctx context.Context) (any, error) {
-			return obj.ServerName, nil
+			return obj.Evictions, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DatabaseInfo_serverName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CacheHealth_evictions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DatabaseInfo",
+		Object:     "CacheHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DatabaseInfo_serverVersion(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _CacheHealth_purges(ctx context.Context, field graphql.CollectedField, obj *model.CacheHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DatabaseInfo_serverVersion,
+		ec.fieldContext_CacheHealth_purges,
 		func(ctx context.Context) (any, error) {
-			return obj.ServerVersion, nil
+			return obj.Purges, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DatabaseInfo_serverVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CacheHealth_purges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DatabaseInfo",
+		Object:     "CacheHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Definition_grade(ctx context.Context, field graphql.CollectedField, obj *model.Definition) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConjugationResponse_rule(ctx context.Context, field graphql.CollectedField, obj *model.ConjugationResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Definition_grade,
+		ec.fieldContext_ConjugationResponse_rule,
 		func(ctx context.Context) (any, error) {
-			return obj.Grade, nil
+			return obj.Rule, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Definition_grade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConjugationResponse_rule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Definition",
+		Object:     "ConjugationResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Definition_meanings(ctx context.Context, field graphql.CollectedField, obj *model.Definition) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConjugationResponse_word(ctx context.Context, field graphql.CollectedField, obj *model.ConjugationResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Definition_meanings,
+		ec.fieldContext_ConjugationResponse_word,
 		func(ctx context.Context) (any, error) {
-			return obj.Meanings, nil
+			return obj.Word, nil
 		},
 		nil,
-		ec.marshalNMeaning2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaningᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Definition_meanings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConjugationResponse_word(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Definition",
+		Object:     "ConjugationResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "language":
-				return ec.fieldContext_Meaning_language(ctx, field)
-			case "definition":
-				return ec.fieldContext_Meaning_definition(ctx, field)
-			case "notes":
-				return ec.fieldContext_Meaning_notes(ctx, field)
-			case "example":
-				return ec.fieldContext_Meaning_example(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Meaning", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EukleidesTopFive_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionPageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.ConnectionPageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EukleidesTopFive_serviceName,
+		ec.fieldContext_ConnectionPageInfo_hasNextPage,
 		func(ctx context.Context) (any, error) {
-			return obj.ServiceName, nil
+			return obj.HasNextPage, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EukleidesTopFive_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionPageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EukleidesTopFive",
+		Object:     "ConnectionPageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EukleidesTopFive_word(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionPageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.ConnectionPageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EukleidesTopFive_word,
+		ec.fieldContext_ConnectionPageInfo_hasPreviousPage,
 		func(ctx context.Context) (any, error) {
-			return obj.Word, nil
+			return obj.HasPreviousPage, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EukleidesTopFive_word(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionPageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EukleidesTopFive",
+		Object:     "ConnectionPageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EukleidesTopFive_lastUsed(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionPageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.ConnectionPageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EukleidesTopFive_lastUsed,
+		ec.fieldContext_ConnectionPageInfo_startCursor,
 		func(ctx context.Context) (any, error) {
-			return obj.LastUsed, nil
+			return obj.StartCursor, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -1738,9 +2628,9 @@ func (ec *executionContext) _EukleidesTopFive_lastUsed(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_EukleidesTopFive_lastUsed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionPageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EukleidesTopFive",
+		Object:     "ConnectionPageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1751,321 +2641,267 @@ func (ec *executionContext) fieldContext_EukleidesTopFive_lastUsed(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _EukleidesTopFive_count(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionPageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.ConnectionPageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EukleidesTopFive_count,
+		ec.fieldContext_ConnectionPageInfo_endCursor,
 		func(ctx context.Context) (any, error) {
-			return obj.Count, nil
+			return obj.EndCursor, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_EukleidesTopFive_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionPageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EukleidesTopFive",
+		Object:     "ConnectionPageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EukleidesTopFiveResponse_topFive(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFiveResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DatabaseInfo_healthy(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EukleidesTopFiveResponse_topFive,
+		ec.fieldContext_DatabaseInfo_healthy,
 		func(ctx context.Context) (any, error) {
-			return obj.TopFive, nil
+			return obj.Healthy, nil
 		},
 		nil,
-		ec.marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveᚄ,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EukleidesTopFiveResponse_topFive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DatabaseInfo_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EukleidesTopFiveResponse",
+		Object:     "DatabaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "serviceName":
-				return ec.fieldContext_EukleidesTopFive_serviceName(ctx, field)
-			case "word":
-				return ec.fieldContext_EukleidesTopFive_word(ctx, field)
-			case "lastUsed":
-				return ec.fieldContext_EukleidesTopFive_lastUsed(ctx, field)
-			case "count":
-				return ec.fieldContext_EukleidesTopFive_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFive", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtendedResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DatabaseInfo_clusterName(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtendedResponse_results,
+		ec.fieldContext_DatabaseInfo_clusterName,
 		func(ctx context.Context) (any, error) {
-			return obj.Results, nil
+			return obj.ClusterName, nil
 		},
 		nil,
-		ec.marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtendedResponse_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DatabaseInfo_clusterName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtendedResponse",
+		Object:     "DatabaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Lemma_id(ctx, field)
-			case "headword":
-				return ec.fieldContext_Lemma_headword(ctx, field)
-			case "normalized":
-				return ec.fieldContext_Lemma_normalized(ctx, field)
-			case "linkedWord":
-				return ec.fieldContext_Lemma_linkedWord(ctx, field)
-			case "partOfSpeech":
-				return ec.fieldContext_Lemma_partOfSpeech(ctx, field)
-			case "article":
-				return ec.fieldContext_Lemma_article(ctx, field)
-			case "gender":
-				return ec.fieldContext_Lemma_gender(ctx, field)
-			case "noun":
-				return ec.fieldContext_Lemma_noun(ctx, field)
-			case "verb":
-				return ec.fieldContext_Lemma_verb(ctx, field)
-			case "quickGlosses":
-				return ec.fieldContext_Lemma_quickGlosses(ctx, field)
-			case "definitions":
-				return ec.fieldContext_Lemma_definitions(ctx, field)
-			case "modernConnections":
-				return ec.fieldContext_Lemma_modernConnections(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Lemma", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtendedResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DatabaseInfo_serverName(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtendedResponse_pageInfo,
+		ec.fieldContext_DatabaseInfo_serverName,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.ServerName, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐPageInfo,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtendedResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DatabaseInfo_serverName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtendedResponse",
+		Object:     "DatabaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "size":
-				return ec.fieldContext_PageInfo_size(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtendedResponse_similarWords(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DatabaseInfo_serverVersion(ctx context.Context, field graphql.CollectedField, obj *model.DatabaseInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtendedResponse_similarWords,
+		ec.fieldContext_DatabaseInfo_serverVersion,
 		func(ctx context.Context) (any, error) {
-			return obj.SimilarWords, nil
+			return obj.ServerVersion, nil
 		},
 		nil,
-		ec.marshalOHit2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐHit,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtendedResponse_similarWords(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DatabaseInfo_serverVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtendedResponse",
+		Object:     "DatabaseInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "dutch":
-				return ec.fieldContext_Hit_dutch(ctx, field)
-			case "english":
-				return ec.fieldContext_Hit_english(ctx, field)
-			case "greek":
-				return ec.fieldContext_Hit_greek(ctx, field)
-			case "linkedWord":
-				return ec.fieldContext_Hit_linkedWord(ctx, field)
-			case "original":
-				return ec.fieldContext_Hit_original(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Hit", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtendedResponse_foundInText(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Definition_grade(ctx context.Context, field graphql.CollectedField, obj *model.Definition) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtendedResponse_foundInText,
+		ec.fieldContext_Definition_grade,
 		func(ctx context.Context) (any, error) {
-			return obj.FoundInText, nil
+			return obj.Grade, nil
 		},
 		nil,
-		ec.marshalOAnalyzeTextResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAnalyzeTextResponse,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtendedResponse_foundInText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Definition_grade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtendedResponse",
+		Object:     "Definition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "conjugations":
-				return ec.fieldContext_AnalyzeTextResponse_conjugations(ctx, field)
-			case "texts":
-				return ec.fieldContext_AnalyzeTextResponse_texts(ctx, field)
-			case "rootword":
-				return ec.fieldContext_AnalyzeTextResponse_rootword(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AnalyzeTextResponse", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HealthResponse_healthy(ctx context.Context, field graphql.CollectedField, obj *model.HealthResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Definition_meanings(ctx context.Context, field graphql.CollectedField, obj *model.Definition) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_HealthResponse_healthy,
+		ec.fieldContext_Definition_meanings,
 		func(ctx context.Context) (any, error) {
-			return obj.Healthy, nil
+			return obj.Meanings, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNMeaning2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaningᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_HealthResponse_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Definition_meanings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HealthResponse",
+		Object:     "Definition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "language":
+				return ec.fieldContext_Meaning_language(ctx, field)
+			case "definition":
+				return ec.fieldContext_Meaning_definition(ctx, field)
+			case "notes":
+				return ec.fieldContext_Meaning_notes(ctx, field)
+			case "example":
+				return ec.fieldContext_Meaning_example(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Meaning", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HealthResponse_time(ctx context.Context, field graphql.CollectedField, obj *model.HealthResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DictionaryEvent_status(ctx context.Context, field graphql.CollectedField, obj *model.DictionaryEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_HealthResponse_time,
+		ec.fieldContext_DictionaryEvent_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Time, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNDictionaryStatus2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDictionaryStatus,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_HealthResponse_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DictionaryEvent_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HealthResponse",
+		Object:     "DictionaryEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DictionaryStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HealthResponse_version(ctx context.Context, field graphql.CollectedField, obj *model.HealthResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DictionaryEvent_time(ctx context.Context, field graphql.CollectedField, obj *model.DictionaryEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_HealthResponse_version,
+		ec.fieldContext_DictionaryEvent_time,
 		func(ctx context.Context) (any, error) {
-			return obj.Version, nil
+			return obj.Time, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_HealthResponse_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DictionaryEvent_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HealthResponse",
+		Object:     "DictionaryEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2076,25 +2912,25 @@ func (ec *executionContext) fieldContext_HealthResponse_version(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Hit_dutch(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopFive_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Hit_dutch,
+		ec.fieldContext_EukleidesTopFive_serviceName,
 		func(ctx context.Context) (any, error) {
-			return obj.Dutch, nil
+			return obj.ServiceName, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Hit_dutch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopFive_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Hit",
+		Object:     "EukleidesTopFive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2105,25 +2941,25 @@ func (ec *executionContext) fieldContext_Hit_dutch(_ context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _Hit_english(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopFive_word(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Hit_english,
+		ec.fieldContext_EukleidesTopFive_word,
 		func(ctx context.Context) (any, error) {
-			return obj.English, nil
+			return obj.Word, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Hit_english(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopFive_word(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Hit",
+		Object:     "EukleidesTopFive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2134,14 +2970,14 @@ func (ec *executionContext) fieldContext_Hit_english(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Hit_greek(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopFive_lastUsed(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Hit_greek,
+		ec.fieldContext_EukleidesTopFive_lastUsed,
 		func(ctx context.Context) (any, error) {
-			return obj.Greek, nil
+			return obj.LastUsed, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2150,9 +2986,9 @@ func (ec *executionContext) _Hit_greek(ctx context.Context, field graphql.Collec
 	)
 }
 
-func (ec *executionContext) fieldContext_Hit_greek(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopFive_lastUsed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Hit",
+		Object:     "EukleidesTopFive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2163,459 +2999,509 @@ func (ec *executionContext) fieldContext_Hit_greek(_ context.Context, field grap
 	return fc, nil
 }
 
-func (ec *executionContext) _Hit_linkedWord(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopFive_count(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Hit_linkedWord,
+		ec.fieldContext_EukleidesTopFive_count,
 		func(ctx context.Context) (any, error) {
-			return obj.LinkedWord, nil
+			return obj.Count, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Hit_linkedWord(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopFive_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Hit",
+		Object:     "EukleidesTopFive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Hit_original(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopFiveResponse_topFive(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopFiveResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Hit_original,
+		ec.fieldContext_EukleidesTopFiveResponse_topFive,
 		func(ctx context.Context) (any, error) {
-			return obj.Original, nil
+			return obj.TopFive, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Hit_original(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopFiveResponse_topFive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Hit",
+		Object:     "EukleidesTopFiveResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "serviceName":
+				return ec.fieldContext_EukleidesTopFive_serviceName(ctx, field)
+			case "word":
+				return ec.fieldContext_EukleidesTopFive_word(ctx, field)
+			case "lastUsed":
+				return ec.fieldContext_EukleidesTopFive_lastUsed(ctx, field)
+			case "count":
+				return ec.fieldContext_EukleidesTopFive_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFive", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_id(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopPage_entries(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopPage) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_id,
+		ec.fieldContext_EukleidesTopPage_entries,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Entries, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopPage_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "EukleidesTopPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "serviceName":
+				return ec.fieldContext_EukleidesTopFive_serviceName(ctx, field)
+			case "word":
+				return ec.fieldContext_EukleidesTopFive_word(ctx, field)
+			case "lastUsed":
+				return ec.fieldContext_EukleidesTopFive_lastUsed(ctx, field)
+			case "count":
+				return ec.fieldContext_EukleidesTopFive_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFive", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_headword(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopPage_total(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopPage) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_headword,
+		ec.fieldContext_EukleidesTopPage_total,
 		func(ctx context.Context) (any, error) {
-			return obj.Headword, nil
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_headword(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopPage_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "EukleidesTopPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_normalized(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopPage_limit(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopPage) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_normalized,
+		ec.fieldContext_EukleidesTopPage_limit,
 		func(ctx context.Context) (any, error) {
-			return obj.Normalized, nil
+			return obj.Limit, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_normalized(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopPage_limit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "EukleidesTopPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_linkedWord(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _EukleidesTopPage_offset(ctx context.Context, field graphql.CollectedField, obj *model.EukleidesTopPage) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_linkedWord,
+		ec.fieldContext_EukleidesTopPage_offset,
 		func(ctx context.Context) (any, error) {
-			return obj.LinkedWord, nil
+			return obj.Offset, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_linkedWord(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_EukleidesTopPage_offset(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "EukleidesTopPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_partOfSpeech(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtendedResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_partOfSpeech,
+		ec.fieldContext_ExtendedResponse_results,
 		func(ctx context.Context) (any, error) {
-			return obj.PartOfSpeech, nil
+			return obj.Results, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_partOfSpeech(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendedResponse_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "ExtendedResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Lemma_id(ctx, field)
+			case "headword":
+				return ec.fieldContext_Lemma_headword(ctx, field)
+			case "normalized":
+				return ec.fieldContext_Lemma_normalized(ctx, field)
+			case "linkedWord":
+				return ec.fieldContext_Lemma_linkedWord(ctx, field)
+			case "partOfSpeech":
+				return ec.fieldContext_Lemma_partOfSpeech(ctx, field)
+			case "article":
+				return ec.fieldContext_Lemma_article(ctx, field)
+			case "gender":
+				return ec.fieldContext_Lemma_gender(ctx, field)
+			case "noun":
+				return ec.fieldContext_Lemma_noun(ctx, field)
+			case "verb":
+				return ec.fieldContext_Lemma_verb(ctx, field)
+			case "quickGlosses":
+				return ec.fieldContext_Lemma_quickGlosses(ctx, field)
+			case "definitions":
+				return ec.fieldContext_Lemma_definitions(ctx, field)
+			case "modernConnections":
+				return ec.fieldContext_Lemma_modernConnections(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lemma", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_article(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtendedResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_article,
+		ec.fieldContext_ExtendedResponse_pageInfo,
 		func(ctx context.Context) (any, error) {
-			return obj.Article, nil
+			return obj.PageInfo, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐPageInfo,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_article(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendedResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "ExtendedResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "size":
+				return ec.fieldContext_PageInfo_size(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "nextCursor":
+				return ec.fieldContext_PageInfo_nextCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_gender(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtendedResponse_similarWords(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_gender,
+		ec.fieldContext_ExtendedResponse_similarWords,
 		func(ctx context.Context) (any, error) {
-			return obj.Gender, nil
+			return obj.SimilarWords, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOHit2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐHit,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendedResponse_similarWords(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "ExtendedResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "dutch":
+				return ec.fieldContext_Hit_dutch(ctx, field)
+			case "english":
+				return ec.fieldContext_Hit_english(ctx, field)
+			case "greek":
+				return ec.fieldContext_Hit_greek(ctx, field)
+			case "linkedWord":
+				return ec.fieldContext_Hit_linkedWord(ctx, field)
+			case "original":
+				return ec.fieldContext_Hit_original(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Hit", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_noun(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtendedResponse_foundInText(ctx context.Context, field graphql.CollectedField, obj *model.ExtendedResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_noun,
+		ec.fieldContext_ExtendedResponse_foundInText,
 		func(ctx context.Context) (any, error) {
-			return obj.Noun, nil
+			return obj.FoundInText, nil
 		},
 		nil,
-		ec.marshalONounInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐNounInfo,
+		ec.marshalOAnalyzeTextResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAnalyzeTextResponse,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_noun(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtendedResponse_foundInText(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "ExtendedResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "declension":
-				return ec.fieldContext_NounInfo_declension(ctx, field)
-			case "genitive":
-				return ec.fieldContext_NounInfo_genitive(ctx, field)
+			case "conjugations":
+				return ec.fieldContext_AnalyzeTextResponse_conjugations(ctx, field)
+			case "texts":
+				return ec.fieldContext_AnalyzeTextResponse_texts(ctx, field)
+			case "rootword":
+				return ec.fieldContext_AnalyzeTextResponse_rootword(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type NounInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AnalyzeTextResponse", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_verb(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _HealthResponse_healthy(ctx context.Context, field graphql.CollectedField, obj *model.HealthResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_verb,
+		ec.fieldContext_HealthResponse_healthy,
 		func(ctx context.Context) (any, error) {
-			return obj.Verb, nil
+			return obj.Healthy, nil
 		},
 		nil,
-		ec.marshalOVerbInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐVerbInfo,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_verb(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HealthResponse_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "HealthResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "principalParts":
-				return ec.fieldContext_VerbInfo_principalParts(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VerbInfo", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_quickGlosses(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _HealthResponse_time(ctx context.Context, field graphql.CollectedField, obj *model.HealthResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_quickGlosses,
+		ec.fieldContext_HealthResponse_time,
 		func(ctx context.Context) (any, error) {
-			return obj.QuickGlosses, nil
+			return obj.Time, nil
 		},
 		nil,
-		ec.marshalNLocalizedGloss2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGlossᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_quickGlosses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HealthResponse_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "HealthResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "language":
-				return ec.fieldContext_LocalizedGloss_language(ctx, field)
-			case "gloss":
-				return ec.fieldContext_LocalizedGloss_gloss(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LocalizedGloss", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_definitions(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _HealthResponse_version(ctx context.Context, field graphql.CollectedField, obj *model.HealthResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_definitions,
+		ec.fieldContext_HealthResponse_version,
 		func(ctx context.Context) (any, error) {
-			return obj.Definitions, nil
+			return obj.Version, nil
 		},
 		nil,
-		ec.marshalNDefinition2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinitionᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_definitions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HealthResponse_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "HealthResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "grade":
-				return ec.fieldContext_Definition_grade(ctx, field)
-			case "meanings":
-				return ec.fieldContext_Definition_meanings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Definition", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Lemma_modernConnections(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+func (ec *executionContext) _Hit_dutch(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Lemma_modernConnections,
+		ec.fieldContext_Hit_dutch,
 		func(ctx context.Context) (any, error) {
-			return obj.ModernConnections, nil
+			return obj.Dutch, nil
 		},
 		nil,
-		ec.marshalNModernConnection2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnectionᚄ,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Lemma_modernConnections(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Hit_dutch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Lemma",
+		Object:     "Hit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "term":
-				return ec.fieldContext_ModernConnection_term(ctx, field)
-			case "note":
-				return ec.fieldContext_ModernConnection_note(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ModernConnection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalizedGloss_language(ctx context.Context, field graphql.CollectedField, obj *model.LocalizedGloss) (ret graphql.Marshaler) {
+func (ec *executionContext) _Hit_english(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalizedGloss_language,
+		ec.fieldContext_Hit_english,
 		func(ctx context.Context) (any, error) {
-			return obj.Language, nil
+			return obj.English, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalizedGloss_language(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Hit_english(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalizedGloss",
+		Object:     "Hit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2626,25 +3512,25 @@ func (ec *executionContext) fieldContext_LocalizedGloss_language(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _LocalizedGloss_gloss(ctx context.Context, field graphql.CollectedField, obj *model.LocalizedGloss) (ret graphql.Marshaler) {
+func (ec *executionContext) _Hit_greek(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LocalizedGloss_gloss,
+		ec.fieldContext_Hit_greek,
 		func(ctx context.Context) (any, error) {
-			return obj.Gloss, nil
+			return obj.Greek, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LocalizedGloss_gloss(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Hit_greek(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LocalizedGloss",
+		Object:     "Hit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2655,25 +3541,25 @@ func (ec *executionContext) fieldContext_LocalizedGloss_gloss(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Meaning_language(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+func (ec *executionContext) _Hit_linkedWord(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Meaning_language,
+		ec.fieldContext_Hit_linkedWord,
 		func(ctx context.Context) (any, error) {
-			return obj.Language, nil
+			return obj.LinkedWord, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Meaning_language(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Hit_linkedWord(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Meaning",
+		Object:     "Hit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2684,25 +3570,25 @@ func (ec *executionContext) fieldContext_Meaning_language(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Meaning_definition(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+func (ec *executionContext) _Hit_original(ctx context.Context, field graphql.CollectedField, obj *model.Hit) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Meaning_definition,
+		ec.fieldContext_Hit_original,
 		func(ctx context.Context) (any, error) {
-			return obj.Definition, nil
+			return obj.Original, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Meaning_definition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Hit_original(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Meaning",
+		Object:     "Hit",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2713,159 +3599,159 @@ func (ec *executionContext) fieldContext_Meaning_definition(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Meaning_notes(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+func (ec *executionContext) _IngesterHealth_sent(ctx context.Context, field graphql.CollectedField, obj *model.IngesterHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Meaning_notes,
+		ec.fieldContext_IngesterHealth_sent,
 		func(ctx context.Context) (any, error) {
-			return obj.Notes, nil
+			return obj.Sent, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Meaning_notes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngesterHealth_sent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Meaning",
+		Object:     "IngesterHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Meaning_example(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+func (ec *executionContext) _IngesterHealth_dropped(ctx context.Context, field graphql.CollectedField, obj *model.IngesterHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Meaning_example,
+		ec.fieldContext_IngesterHealth_dropped,
 		func(ctx context.Context) (any, error) {
-			return obj.Example, nil
+			return obj.Dropped, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Meaning_example(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngesterHealth_dropped(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Meaning",
+		Object:     "IngesterHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ModernConnection_term(ctx context.Context, field graphql.CollectedField, obj *model.ModernConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _IngesterHealth_spilled(ctx context.Context, field graphql.CollectedField, obj *model.IngesterHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ModernConnection_term,
+		ec.fieldContext_IngesterHealth_spilled,
 		func(ctx context.Context) (any, error) {
-			return obj.Term, nil
+			return obj.Spilled, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ModernConnection_term(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngesterHealth_spilled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ModernConnection",
+		Object:     "IngesterHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ModernConnection_note(ctx context.Context, field graphql.CollectedField, obj *model.ModernConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _IngesterHealth_reconnects(ctx context.Context, field graphql.CollectedField, obj *model.IngesterHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ModernConnection_note,
+		ec.fieldContext_IngesterHealth_reconnects,
 		func(ctx context.Context) (any, error) {
-			return obj.Note, nil
+			return obj.Reconnects, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ModernConnection_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngesterHealth_reconnects(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ModernConnection",
+		Object:     "IngesterHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NounInfo_declension(ctx context.Context, field graphql.CollectedField, obj *model.NounInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _IngesterHealth_queued(ctx context.Context, field graphql.CollectedField, obj *model.IngesterHealth) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_NounInfo_declension,
+		ec.fieldContext_IngesterHealth_queued,
 		func(ctx context.Context) (any, error) {
-			return obj.Declension, nil
+			return obj.Queued, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_NounInfo_declension(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngesterHealth_queued(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NounInfo",
+		Object:     "IngesterHealth",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NounInfo_genitive(ctx context.Context, field graphql.CollectedField, obj *model.NounInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_id(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_NounInfo_genitive,
+		ec.fieldContext_Lemma_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Genitive, nil
+			return obj.ID, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2874,9 +3760,9 @@ func (ec *executionContext) _NounInfo_genitive(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_NounInfo_genitive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NounInfo",
+		Object:     "Lemma",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2887,631 +3773,2759 @@ func (ec *executionContext) fieldContext_NounInfo_genitive(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_headword(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_page,
+		ec.fieldContext_Lemma_headword,
 		func(ctx context.Context) (any, error) {
-			return obj.Page, nil
+			return obj.Headword, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_headword(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Lemma",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_size(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_normalized(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_size,
+		ec.fieldContext_Lemma_normalized,
 		func(ctx context.Context) (any, error) {
-			return obj.Size, nil
+			return obj.Normalized, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_normalized(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Lemma",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_linkedWord(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_total,
+		ec.fieldContext_Lemma_linkedWord,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.LinkedWord, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_linkedWord(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Lemma",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_partOfSpeech(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_health,
+		ec.fieldContext_Lemma_partOfSpeech,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Health(ctx)
+			return obj.PartOfSpeech, nil
 		},
 		nil,
-		ec.marshalNAggregatedHealthResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAggregatedHealthResponse,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_partOfSpeech(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Lemma",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "healthy":
-				return ec.fieldContext_AggregatedHealthResponse_healthy(ctx, field)
-			case "time":
-				return ec.fieldContext_AggregatedHealthResponse_time(ctx, field)
-			case "version":
-				return ec.fieldContext_AggregatedHealthResponse_version(ctx, field)
-			case "services":
-				return ec.fieldContext_AggregatedHealthResponse_services(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AggregatedHealthResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_counterTopFive(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_article(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_counterTopFive,
+		ec.fieldContext_Lemma_article,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().CounterTopFive(ctx)
+			return obj.Article, nil
 		},
 		nil,
-		ec.marshalNEukleidesTopFiveResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_counterTopFive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_article(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Lemma",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "topFive":
-				return ec.fieldContext_EukleidesTopFiveResponse_topFive(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFiveResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_counterService(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_gender(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_counterService,
+		ec.fieldContext_Lemma_gender,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().CounterService(ctx, fc.Args["name"].(string))
+			return obj.Gender, nil
 		},
 		nil,
-		ec.marshalNEukleidesTopFive2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_counterService(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Lemma",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "serviceName":
-				return ec.fieldContext_EukleidesTopFive_serviceName(ctx, field)
-			case "word":
-				return ec.fieldContext_EukleidesTopFive_word(ctx, field)
-			case "lastUsed":
-				return ec.fieldContext_EukleidesTopFive_lastUsed(ctx, field)
-			case "count":
-				return ec.fieldContext_EukleidesTopFive_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_counterService_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_counterSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_noun(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_counterSession,
+		ec.fieldContext_Lemma_noun,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().CounterSession(ctx, fc.Args["sessionId"].(string))
+			return obj.Noun, nil
 		},
 		nil,
-		ec.marshalNEukleidesTopFiveResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse,
-		true,
+		ec.marshalONounInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐNounInfo,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_counterSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_noun(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Lemma",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "topFive":
-				return ec.fieldContext_EukleidesTopFiveResponse_topFive(ctx, field)
+			case "declension":
+				return ec.fieldContext_NounInfo_declension(ctx, field)
+			case "genitive":
+				return ec.fieldContext_NounInfo_genitive(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFiveResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type NounInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_counterSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_text(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_verb(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_text,
+		ec.fieldContext_Lemma_verb,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Text(ctx, fc.Args["input"].(model.ExpandableSearchQueryInput))
+			return obj.Verb, nil
 		},
 		nil,
-		ec.marshalNExtendedResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse,
-		true,
+		ec.marshalOVerbInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐVerbInfo,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_verb(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Lemma",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "results":
-				return ec.fieldContext_ExtendedResponse_results(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_ExtendedResponse_pageInfo(ctx, field)
-			case "similarWords":
-				return ec.fieldContext_ExtendedResponse_similarWords(ctx, field)
-			case "foundInText":
-				return ec.fieldContext_ExtendedResponse_foundInText(ctx, field)
+			case "principalParts":
+				return ec.fieldContext_VerbInfo_principalParts(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ExtendedResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type VerbInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_text_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _Lemma_quickGlosses(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Lemma_quickGlosses,
+		func(ctx context.Context) (any, error) {
+			return obj.QuickGlosses, nil
+		},
+		nil,
+		ec.marshalNLocalizedGloss2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGlossᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Lemma_quickGlosses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Lemma",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "language":
+				return ec.fieldContext_LocalizedGloss_language(ctx, field)
+			case "gloss":
+				return ec.fieldContext_LocalizedGloss_gloss(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LocalizedGloss", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fuzzy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Lemma_definitions(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_fuzzy,
+		ec.fieldContext_Lemma_definitions,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Fuzzy(ctx, fc.Args["input"].(model.SearchQueryInput))
+			return obj.Definitions, nil
 		},
 		nil,
-		ec.marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse,
+		ec.marshalNDefinition2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinitionᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_fuzzy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Lemma_definitions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Lemma",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "results":
-				return ec.fieldContext_SearchResponse_results(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_SearchResponse_pageInfo(ctx, field)
+			case "grade":
+				return ec.fieldContext_Definition_grade(ctx, field)
+			case "meanings":
+				return ec.fieldContext_Definition_meanings(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SearchResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Definition", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fuzzy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _Lemma_modernConnections(ctx context.Context, field graphql.CollectedField, obj *model.Lemma) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Lemma_modernConnections,
+		func(ctx context.Context) (any, error) {
+			return obj.ModernConnections, nil
+		},
+		nil,
+		ec.marshalNModernConnection2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnectionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Lemma_modernConnections(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Lemma",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "term":
+				return ec.fieldContext_ModernConnection_term(ctx, field)
+			case "note":
+				return ec.fieldContext_ModernConnection_note(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ModernConnection", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_exact(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _LemmaConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.LemmaConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_exact,
+		ec.fieldContext_LemmaConnection_edges,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Exact(ctx, fc.Args["input"].(model.ExpandableSearchQueryInput))
+			return obj.Edges, nil
 		},
 		nil,
-		ec.marshalNExtendedResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse,
+		ec.marshalNLemmaEdge2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaEdgeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_exact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LemmaConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "LemmaConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "results":
-				return ec.fieldContext_ExtendedResponse_results(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_ExtendedResponse_pageInfo(ctx, field)
-			case "similarWords":
-				return ec.fieldContext_ExtendedResponse_similarWords(ctx, field)
-			case "foundInText":
-				return ec.fieldContext_ExtendedResponse_foundInText(ctx, field)
+			case "node":
+				return ec.fieldContext_LemmaEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LemmaEdge_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ExtendedResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LemmaEdge", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_exact_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	return fc, nil
+}
+
+func (ec *executionContext) _LemmaConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.LemmaConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LemmaConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNConnectionPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐConnectionPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LemmaConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LemmaConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_ConnectionPageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_ConnectionPageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_ConnectionPageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_ConnectionPageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConnectionPageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LemmaConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.LemmaConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LemmaConnection_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LemmaConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LemmaConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LemmaEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.LemmaEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LemmaEdge_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalNLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemma,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LemmaEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LemmaEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Lemma_id(ctx, field)
+			case "headword":
+				return ec.fieldContext_Lemma_headword(ctx, field)
+			case "normalized":
+				return ec.fieldContext_Lemma_normalized(ctx, field)
+			case "linkedWord":
+				return ec.fieldContext_Lemma_linkedWord(ctx, field)
+			case "partOfSpeech":
+				return ec.fieldContext_Lemma_partOfSpeech(ctx, field)
+			case "article":
+				return ec.fieldContext_Lemma_article(ctx, field)
+			case "gender":
+				return ec.fieldContext_Lemma_gender(ctx, field)
+			case "noun":
+				return ec.fieldContext_Lemma_noun(ctx, field)
+			case "verb":
+				return ec.fieldContext_Lemma_verb(ctx, field)
+			case "quickGlosses":
+				return ec.fieldContext_Lemma_quickGlosses(ctx, field)
+			case "definitions":
+				return ec.fieldContext_Lemma_definitions(ctx, field)
+			case "modernConnections":
+				return ec.fieldContext_Lemma_modernConnections(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lemma", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LemmaEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.LemmaEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LemmaEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LemmaEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LemmaEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalizedGloss_language(ctx context.Context, field graphql.CollectedField, obj *model.LocalizedGloss) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalizedGloss_language,
+		func(ctx context.Context) (any, error) {
+			return obj.Language, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalizedGloss_language(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalizedGloss",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LocalizedGloss_gloss(ctx context.Context, field graphql.CollectedField, obj *model.LocalizedGloss) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LocalizedGloss_gloss,
+		func(ctx context.Context) (any, error) {
+			return obj.Gloss, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LocalizedGloss_gloss(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LocalizedGloss",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Meaning_language(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Meaning_language,
+		func(ctx context.Context) (any, error) {
+			return obj.Language, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Meaning_language(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Meaning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Meaning_definition(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Meaning_definition,
+		func(ctx context.Context) (any, error) {
+			return obj.Definition, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Meaning_definition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Meaning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Meaning_notes(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Meaning_notes,
+		func(ctx context.Context) (any, error) {
+			return obj.Notes, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Meaning_notes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Meaning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Meaning_example(ctx context.Context, field graphql.CollectedField, obj *model.Meaning) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Meaning_example,
+		func(ctx context.Context) (any, error) {
+			return obj.Example, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Meaning_example(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Meaning",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ModernConnection_term(ctx context.Context, field graphql.CollectedField, obj *model.ModernConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ModernConnection_term,
+		func(ctx context.Context) (any, error) {
+			return obj.Term, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ModernConnection_term(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ModernConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ModernConnection_note(ctx context.Context, field graphql.CollectedField, obj *model.ModernConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ModernConnection_note,
+		func(ctx context.Context) (any, error) {
+			return obj.Note, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ModernConnection_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ModernConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NounInfo_declension(ctx context.Context, field graphql.CollectedField, obj *model.NounInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NounInfo_declension,
+		func(ctx context.Context) (any, error) {
+			return obj.Declension, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_NounInfo_declension(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NounInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NounInfo_genitive(ctx context.Context, field graphql.CollectedField, obj *model.NounInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NounInfo_genitive,
+		func(ctx context.Context) (any, error) {
+			return obj.Genitive, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_NounInfo_genitive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NounInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_page,
+		func(ctx context.Context) (any, error) {
+			return obj.Page, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_size(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_size,
+		func(ctx context.Context) (any, error) {
+			return obj.Size, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_size(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_nextCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_nextCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.NextCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_nextCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_health,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Health(ctx)
+		},
+		nil,
+		ec.marshalNAggregatedHealthResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAggregatedHealthResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "healthy":
+				return ec.fieldContext_AggregatedHealthResponse_healthy(ctx, field)
+			case "degraded":
+				return ec.fieldContext_AggregatedHealthResponse_degraded(ctx, field)
+			case "time":
+				return ec.fieldContext_AggregatedHealthResponse_time(ctx, field)
+			case "version":
+				return ec.fieldContext_AggregatedHealthResponse_version(ctx, field)
+			case "services":
+				return ec.fieldContext_AggregatedHealthResponse_services(ctx, field)
+			case "cache":
+				return ec.fieldContext_AggregatedHealthResponse_cache(ctx, field)
+			case "ingester":
+				return ec.fieldContext_AggregatedHealthResponse_ingester(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AggregatedHealthResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_counterTopFive(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_counterTopFive,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CounterTopFive(ctx, fc.Args["window"].(*model.CounterWindow))
+		},
+		nil,
+		ec.marshalNEukleidesTopFiveResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_counterTopFive(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "topFive":
+				return ec.fieldContext_EukleidesTopFiveResponse_topFive(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFiveResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_counterTopFive_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_counterService(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_counterService,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CounterService(ctx, fc.Args["name"].(string), fc.Args["window"].(*model.CounterWindow))
+		},
+		nil,
+		ec.marshalNEukleidesTopFive2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_counterService(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "serviceName":
+				return ec.fieldContext_EukleidesTopFive_serviceName(ctx, field)
+			case "word":
+				return ec.fieldContext_EukleidesTopFive_word(ctx, field)
+			case "lastUsed":
+				return ec.fieldContext_EukleidesTopFive_lastUsed(ctx, field)
+			case "count":
+				return ec.fieldContext_EukleidesTopFive_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFive", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_counterService_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_counterSession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_counterSession,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CounterSession(ctx, fc.Args["sessionId"].(string))
+		},
+		nil,
+		ec.marshalNEukleidesTopFiveResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_counterSession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "topFive":
+				return ec.fieldContext_EukleidesTopFiveResponse_topFive(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopFiveResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_counterSession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_counterTop(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_counterTop,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CounterTop(ctx, fc.Args["input"].(*model.CounterTopInput))
+		},
+		nil,
+		ec.marshalNEukleidesTopPage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopPage,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_counterTop(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entries":
+				return ec.fieldContext_EukleidesTopPage_entries(ctx, field)
+			case "total":
+				return ec.fieldContext_EukleidesTopPage_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_EukleidesTopPage_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_EukleidesTopPage_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopPage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_counterTop_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_counterZeroResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_counterZeroResults,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CounterZeroResults(ctx, fc.Args["input"].(*model.ZeroResultsInput))
+		},
+		nil,
+		ec.marshalNEukleidesTopPage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopPage,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_counterZeroResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entries":
+				return ec.fieldContext_EukleidesTopPage_entries(ctx, field)
+			case "total":
+				return ec.fieldContext_EukleidesTopPage_total(ctx, field)
+			case "limit":
+				return ec.fieldContext_EukleidesTopPage_limit(ctx, field)
+			case "offset":
+				return ec.fieldContext_EukleidesTopPage_offset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EukleidesTopPage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_counterZeroResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_trending(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_trending,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Trending(ctx, fc.Args["window"].(*model.CounterWindow), fc.Args["serviceName"].(*string), fc.Args["limit"].(*int32), fc.Args["minCount"].(*int32))
+		},
+		nil,
+		ec.marshalNTrendingWord2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐTrendingWordᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_trending(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "serviceName":
+				return ec.fieldContext_TrendingWord_serviceName(ctx, field)
+			case "word":
+				return ec.fieldContext_TrendingWord_word(ctx, field)
+			case "recentCount":
+				return ec.fieldContext_TrendingWord_recentCount(ctx, field)
+			case "baselineAverage":
+				return ec.fieldContext_TrendingWord_baselineAverage(ctx, field)
+			case "score":
+				return ec.fieldContext_TrendingWord_score(ctx, field)
+			case "lastUsed":
+				return ec.fieldContext_TrendingWord_lastUsed(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TrendingWord", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_trending_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_text(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_text,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Text(ctx, fc.Args["input"].(model.ExpandableSearchQueryInput))
+		},
+		nil,
+		ec.marshalNExtendedResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_text(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_ExtendedResponse_results(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ExtendedResponse_pageInfo(ctx, field)
+			case "similarWords":
+				return ec.fieldContext_ExtendedResponse_similarWords(ctx, field)
+			case "foundInText":
+				return ec.fieldContext_ExtendedResponse_foundInText(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExtendedResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_text_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fuzzy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_fuzzy,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Fuzzy(ctx, fc.Args["input"].(model.SearchQueryInput))
+		},
+		nil,
+		ec.marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_fuzzy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_SearchResponse_results(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SearchResponse_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fuzzy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_exact(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_exact,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Exact(ctx, fc.Args["input"].(model.ExpandableSearchQueryInput))
+		},
+		nil,
+		ec.marshalNExtendedResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_exact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_ExtendedResponse_results(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ExtendedResponse_pageInfo(ctx, field)
+			case "similarWords":
+				return ec.fieldContext_ExtendedResponse_similarWords(ctx, field)
+			case "foundInText":
+				return ec.fieldContext_ExtendedResponse_foundInText(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExtendedResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_exact_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_phrase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_phrase,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Phrase(ctx, fc.Args["input"].(model.SearchQueryInput))
+		},
+		nil,
+		ec.marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_phrase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_SearchResponse_results(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SearchResponse_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_phrase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_partial(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_partial,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Partial(ctx, fc.Args["input"].(model.SearchQueryInput))
+		},
+		nil,
+		ec.marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_partial(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_SearchResponse_results(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SearchResponse_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_partial_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_search(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_search,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Search(ctx, fc.Args["input"].(model.UnifiedSearchInput))
+		},
+		nil,
+		ec.marshalNUnifiedSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐUnifiedSearchResponse,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_search(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "results":
+				return ec.fieldContext_UnifiedSearchResponse_results(ctx, field)
+			case "timings":
+				return ec.fieldContext_UnifiedSearchResponse_timings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UnifiedSearchResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_search_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_exactConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_exactConnection,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ExactConnection(ctx, fc.Args["word"].(string), fc.Args["language"].(*model.Language), fc.Args["first"].(*int32), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNLemmaConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_exactConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LemmaConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LemmaConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LemmaConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LemmaConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_exactConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fuzzyConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_fuzzyConnection,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FuzzyConnection(ctx, fc.Args["word"].(string), fc.Args["language"].(*model.Language), fc.Args["first"].(*int32), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNLemmaConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_fuzzyConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LemmaConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LemmaConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LemmaConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LemmaConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fuzzyConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_phraseConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_phraseConnection,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PhraseConnection(ctx, fc.Args["word"].(string), fc.Args["language"].(*model.Language), fc.Args["first"].(*int32), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNLemmaConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_phraseConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LemmaConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LemmaConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LemmaConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LemmaConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_phraseConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_partialConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_partialConnection,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PartialConnection(ctx, fc.Args["word"].(string), fc.Args["language"].(*model.Language), fc.Args["first"].(*int32), fc.Args["after"].(*string))
+		},
+		nil,
+		ec.marshalNLemmaConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_partialConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_LemmaConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_LemmaConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_LemmaConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LemmaConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_partialConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RankedLemma_rank(ctx context.Context, field graphql.CollectedField, obj *model.RankedLemma) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RankedLemma_rank,
+		func(ctx context.Context) (any, error) {
+			return obj.Rank, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RankedLemma_rank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RankedLemma",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RankedLemma_lemma(ctx context.Context, field graphql.CollectedField, obj *model.RankedLemma) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RankedLemma_lemma,
+		func(ctx context.Context) (any, error) {
+			return obj.Lemma, nil
+		},
+		nil,
+		ec.marshalNLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemma,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RankedLemma_lemma(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RankedLemma",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Lemma_id(ctx, field)
+			case "headword":
+				return ec.fieldContext_Lemma_headword(ctx, field)
+			case "normalized":
+				return ec.fieldContext_Lemma_normalized(ctx, field)
+			case "linkedWord":
+				return ec.fieldContext_Lemma_linkedWord(ctx, field)
+			case "partOfSpeech":
+				return ec.fieldContext_Lemma_partOfSpeech(ctx, field)
+			case "article":
+				return ec.fieldContext_Lemma_article(ctx, field)
+			case "gender":
+				return ec.fieldContext_Lemma_gender(ctx, field)
+			case "noun":
+				return ec.fieldContext_Lemma_noun(ctx, field)
+			case "verb":
+				return ec.fieldContext_Lemma_verb(ctx, field)
+			case "quickGlosses":
+				return ec.fieldContext_Lemma_quickGlosses(ctx, field)
+			case "definitions":
+				return ec.fieldContext_Lemma_definitions(ctx, field)
+			case "modernConnections":
+				return ec.fieldContext_Lemma_modernConnections(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lemma", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RankedLemma_strategy(ctx context.Context, field graphql.CollectedField, obj *model.RankedLemma) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RankedLemma_strategy,
+		func(ctx context.Context) (any, error) {
+			return obj.Strategy, nil
+		},
+		nil,
+		ec.marshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RankedLemma_strategy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RankedLemma",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SearchStrategy does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RankedLemma_foundBy(ctx context.Context, field graphql.CollectedField, obj *model.RankedLemma) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RankedLemma_foundBy,
+		func(ctx context.Context) (any, error) {
+			return obj.FoundBy, nil
+		},
+		nil,
+		ec.marshalNSearchStrategy2ᚕgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategyᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RankedLemma_foundBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RankedLemma",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SearchStrategy does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rhema_greek(ctx context.Context, field graphql.CollectedField, obj *model.Rhema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rhema_greek,
+		func(ctx context.Context) (any, error) {
+			return obj.Greek, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rhema_greek(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rhema",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rhema_section(ctx context.Context, field graphql.CollectedField, obj *model.Rhema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rhema_section,
+		func(ctx context.Context) (any, error) {
+			return obj.Section, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rhema_section(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rhema",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rhema_translations(ctx context.Context, field graphql.CollectedField, obj *model.Rhema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rhema_translations,
+		func(ctx context.Context) (any, error) {
+			return obj.Translations, nil
+		},
+		nil,
+		ec.marshalOString2ᚕᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rhema_translations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rhema",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchEvent_word(ctx context.Context, field graphql.CollectedField, obj *model.SearchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchEvent_word,
+		func(ctx context.Context) (any, error) {
+			return obj.Word, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchEvent_word(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchEvent_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.SearchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchEvent_serviceName,
+		func(ctx context.Context) (any, error) {
+			return obj.ServiceName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchEvent_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchEvent_searchType(ctx context.Context, field graphql.CollectedField, obj *model.SearchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchEvent_searchType,
+		func(ctx context.Context) (any, error) {
+			return obj.SearchType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchEvent_searchType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchEvent_language(ctx context.Context, field graphql.CollectedField, obj *model.SearchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchEvent_language,
+		func(ctx context.Context) (any, error) {
+			return obj.Language, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchEvent_language(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchEvent_resultCount(ctx context.Context, field graphql.CollectedField, obj *model.SearchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchEvent_resultCount,
+		func(ctx context.Context) (any, error) {
+			return obj.ResultCount, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint32,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchEvent_resultCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchEvent_time(ctx context.Context, field graphql.CollectedField, obj *model.SearchEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchEvent_time,
+		func(ctx context.Context) (any, error) {
+			return obj.Time, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchEvent_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.SearchResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResponse_results,
+		func(ctx context.Context) (any, error) {
+			return obj.Results, nil
+		},
+		nil,
+		ec.marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResponse_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Lemma_id(ctx, field)
+			case "headword":
+				return ec.fieldContext_Lemma_headword(ctx, field)
+			case "normalized":
+				return ec.fieldContext_Lemma_normalized(ctx, field)
+			case "linkedWord":
+				return ec.fieldContext_Lemma_linkedWord(ctx, field)
+			case "partOfSpeech":
+				return ec.fieldContext_Lemma_partOfSpeech(ctx, field)
+			case "article":
+				return ec.fieldContext_Lemma_article(ctx, field)
+			case "gender":
+				return ec.fieldContext_Lemma_gender(ctx, field)
+			case "noun":
+				return ec.fieldContext_Lemma_noun(ctx, field)
+			case "verb":
+				return ec.fieldContext_Lemma_verb(ctx, field)
+			case "quickGlosses":
+				return ec.fieldContext_Lemma_quickGlosses(ctx, field)
+			case "definitions":
+				return ec.fieldContext_Lemma_definitions(ctx, field)
+			case "modernConnections":
+				return ec.fieldContext_Lemma_modernConnections(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Lemma", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SearchResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SearchResponse_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SearchResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "size":
+				return ec.fieldContext_PageInfo_size(ctx, field)
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "nextCursor":
+				return ec.fieldContext_PageInfo_nextCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_name(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_healthy,
+		func(ctx context.Context) (any, error) {
+			return obj.Healthy, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_critical(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_critical,
+		func(ctx context.Context) (any, error) {
+			return obj.Critical, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_critical(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_latencyMs(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_latencyMs,
+		func(ctx context.Context) (any, error) {
+			return obj.LatencyMs, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_latencyMs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_error(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_version(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ServiceHealth_databaseInfo(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ServiceHealth_databaseInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.DatabaseInfo, nil
+		},
+		nil,
+		ec.marshalODatabaseInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDatabaseInfo,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ServiceHealth_databaseInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ServiceHealth",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "healthy":
+				return ec.fieldContext_DatabaseInfo_healthy(ctx, field)
+			case "clusterName":
+				return ec.fieldContext_DatabaseInfo_clusterName(ctx, field)
+			case "serverName":
+				return ec.fieldContext_DatabaseInfo_serverName(ctx, field)
+			case "serverVersion":
+				return ec.fieldContext_DatabaseInfo_serverVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DatabaseInfo", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_phrase(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _StrategyTiming_strategy(ctx context.Context, field graphql.CollectedField, obj *model.StrategyTiming) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_phrase,
+		ec.fieldContext_StrategyTiming_strategy,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Phrase(ctx, fc.Args["input"].(model.SearchQueryInput))
+			return obj.Strategy, nil
 		},
 		nil,
-		ec.marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse,
+		ec.marshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_phrase(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StrategyTiming_strategy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "StrategyTiming",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "results":
-				return ec.fieldContext_SearchResponse_results(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_SearchResponse_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SearchResponse", field.Name)
+			return nil, errors.New("field of type SearchStrategy does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_phrase_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_partial(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _StrategyTiming_durationMs(ctx context.Context, field graphql.CollectedField, obj *model.StrategyTiming) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_partial,
+		ec.fieldContext_StrategyTiming_durationMs,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Partial(ctx, fc.Args["input"].(model.SearchQueryInput))
+			return obj.DurationMs, nil
 		},
 		nil,
-		ec.marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_partial(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StrategyTiming_durationMs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "StrategyTiming",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "results":
-				return ec.fieldContext_SearchResponse_results(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_SearchResponse_pageInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SearchResponse", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_partial_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _StrategyTiming_results(ctx context.Context, field graphql.CollectedField, obj *model.StrategyTiming) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_StrategyTiming_results,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return obj.Results, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StrategyTiming_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "StrategyTiming",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _StrategyTiming_total(ctx context.Context, field graphql.CollectedField, obj *model.StrategyTiming) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext_StrategyTiming_total,
 		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
+			return obj.Total, nil
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StrategyTiming_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "StrategyTiming",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Reference_work(ctx context.Context, field graphql.CollectedField, obj *model.Reference) (ret graphql.Marshaler) {
+func (ec *executionContext) _StrategyTiming_error(ctx context.Context, field graphql.CollectedField, obj *model.StrategyTiming) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Reference_work,
+		ec.fieldContext_StrategyTiming_error,
 		func(ctx context.Context) (any, error) {
-			return obj.Work, nil
+			return obj.Error, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Reference_work(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StrategyTiming_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Reference",
+		Object:     "StrategyTiming",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3522,83 +6536,115 @@ func (ec *executionContext) fieldContext_Reference_work(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Reference_locus(ctx context.Context, field graphql.CollectedField, obj *model.Reference) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+func (ec *executionContext) _Subscription_searches(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Reference_locus,
+		ec.fieldContext_Subscription_searches,
 		func(ctx context.Context) (any, error) {
-			return obj.Locus, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().Searches(ctx, fc.Args["serviceName"].(*string), fc.Args["sessionId"].(*string))
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNSearchEvent2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchEvent,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Reference_locus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_searches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Reference",
+		Object:     "Subscription",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "word":
+				return ec.fieldContext_SearchEvent_word(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_SearchEvent_serviceName(ctx, field)
+			case "searchType":
+				return ec.fieldContext_SearchEvent_searchType(ctx, field)
+			case "language":
+				return ec.fieldContext_SearchEvent_language(ctx, field)
+			case "resultCount":
+				return ec.fieldContext_SearchEvent_resultCount(ctx, field)
+			case "time":
+				return ec.fieldContext_SearchEvent_time(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SearchEvent", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_searches_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Rhema_greek(ctx context.Context, field graphql.CollectedField, obj *model.Rhema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
+func (ec *executionContext) _Subscription_dictionary(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Rhema_greek,
+		ec.fieldContext_Subscription_dictionary,
 		func(ctx context.Context) (any, error) {
-			return obj.Greek, nil
+			return ec.resolvers.Subscription().Dictionary(ctx)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNDictionaryEvent2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDictionaryEvent,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Rhema_greek(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_dictionary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Rhema",
+		Object:     "Subscription",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_DictionaryEvent_status(ctx, field)
+			case "time":
+				return ec.fieldContext_DictionaryEvent_time(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DictionaryEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Rhema_section(ctx context.Context, field graphql.CollectedField, obj *model.Rhema) (ret graphql.Marshaler) {
+func (ec *executionContext) _TrendingWord_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.TrendingWord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Rhema_section,
+		ec.fieldContext_TrendingWord_serviceName,
 		func(ctx context.Context) (any, error) {
-			return obj.Section, nil
+			return obj.ServiceName, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Rhema_section(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TrendingWord_serviceName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Rhema",
+		Object:     "TrendingWord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3609,25 +6655,25 @@ func (ec *executionContext) fieldContext_Rhema_section(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Rhema_translations(ctx context.Context, field graphql.CollectedField, obj *model.Rhema) (ret graphql.Marshaler) {
+func (ec *executionContext) _TrendingWord_word(ctx context.Context, field graphql.CollectedField, obj *model.TrendingWord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Rhema_translations,
+		ec.fieldContext_TrendingWord_word,
 		func(ctx context.Context) (any, error) {
-			return obj.Translations, nil
+			return obj.Word, nil
 		},
 		nil,
-		ec.marshalOString2ᚕᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Rhema_translations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TrendingWord_word(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Rhema",
+		Object:     "TrendingWord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3638,219 +6684,197 @@ func (ec *executionContext) fieldContext_Rhema_translations(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _SearchResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.SearchResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _TrendingWord_recentCount(ctx context.Context, field graphql.CollectedField, obj *model.TrendingWord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SearchResponse_results,
+		ec.fieldContext_TrendingWord_recentCount,
 		func(ctx context.Context) (any, error) {
-			return obj.Results, nil
+			return obj.RecentCount, nil
 		},
 		nil,
-		ec.marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaᚄ,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SearchResponse_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TrendingWord_recentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SearchResponse",
+		Object:     "TrendingWord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Lemma_id(ctx, field)
-			case "headword":
-				return ec.fieldContext_Lemma_headword(ctx, field)
-			case "normalized":
-				return ec.fieldContext_Lemma_normalized(ctx, field)
-			case "linkedWord":
-				return ec.fieldContext_Lemma_linkedWord(ctx, field)
-			case "partOfSpeech":
-				return ec.fieldContext_Lemma_partOfSpeech(ctx, field)
-			case "article":
-				return ec.fieldContext_Lemma_article(ctx, field)
-			case "gender":
-				return ec.fieldContext_Lemma_gender(ctx, field)
-			case "noun":
-				return ec.fieldContext_Lemma_noun(ctx, field)
-			case "verb":
-				return ec.fieldContext_Lemma_verb(ctx, field)
-			case "quickGlosses":
-				return ec.fieldContext_Lemma_quickGlosses(ctx, field)
-			case "definitions":
-				return ec.fieldContext_Lemma_definitions(ctx, field)
-			case "modernConnections":
-				return ec.fieldContext_Lemma_modernConnections(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Lemma", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SearchResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SearchResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _TrendingWord_baselineAverage(ctx context.Context, field graphql.CollectedField, obj *model.TrendingWord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SearchResponse_pageInfo,
+		ec.fieldContext_TrendingWord_baselineAverage,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.BaselineAverage, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐPageInfo,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SearchResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TrendingWord_baselineAverage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SearchResponse",
+		Object:     "TrendingWord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "size":
-				return ec.fieldContext_PageInfo_size(ctx, field)
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ServiceHealth_name(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+func (ec *executionContext) _TrendingWord_score(ctx context.Context, field graphql.CollectedField, obj *model.TrendingWord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ServiceHealth_name,
+		ec.fieldContext_TrendingWord_score,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Score, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ServiceHealth_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TrendingWord_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ServiceHealth",
+		Object:     "TrendingWord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ServiceHealth_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+func (ec *executionContext) _TrendingWord_lastUsed(ctx context.Context, field graphql.CollectedField, obj *model.TrendingWord) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ServiceHealth_healthy,
+		ec.fieldContext_TrendingWord_lastUsed,
 		func(ctx context.Context) (any, error) {
-			return obj.Healthy, nil
+			return obj.LastUsed, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ServiceHealth_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TrendingWord_lastUsed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ServiceHealth",
+		Object:     "TrendingWord",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ServiceHealth_version(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+func (ec *executionContext) _UnifiedSearchResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.UnifiedSearchResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ServiceHealth_version,
+		ec.fieldContext_UnifiedSearchResponse_results,
 		func(ctx context.Context) (any, error) {
-			return obj.Version, nil
+			return obj.Results, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNRankedLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐRankedLemmaᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ServiceHealth_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UnifiedSearchResponse_results(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ServiceHealth",
+		Object:     "UnifiedSearchResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "rank":
+				return ec.fieldContext_RankedLemma_rank(ctx, field)
+			case "lemma":
+				return ec.fieldContext_RankedLemma_lemma(ctx, field)
+			case "strategy":
+				return ec.fieldContext_RankedLemma_strategy(ctx, field)
+			case "foundBy":
+				return ec.fieldContext_RankedLemma_foundBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RankedLemma", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ServiceHealth_databaseInfo(ctx context.Context, field graphql.CollectedField, obj *model.ServiceHealth) (ret graphql.Marshaler) {
+func (ec *executionContext) _UnifiedSearchResponse_timings(ctx context.Context, field graphql.CollectedField, obj *model.UnifiedSearchResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ServiceHealth_databaseInfo,
+		ec.fieldContext_UnifiedSearchResponse_timings,
 		func(ctx context.Context) (any, error) {
-			return obj.DatabaseInfo, nil
+			return obj.Timings, nil
 		},
 		nil,
-		ec.marshalODatabaseInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDatabaseInfo,
+		ec.marshalNStrategyTiming2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐStrategyTimingᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ServiceHealth_databaseInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_UnifiedSearchResponse_timings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ServiceHealth",
+		Object:     "UnifiedSearchResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "healthy":
-				return ec.fieldContext_DatabaseInfo_healthy(ctx, field)
-			case "clusterName":
-				return ec.fieldContext_DatabaseInfo_clusterName(ctx, field)
-			case "serverName":
-				return ec.fieldContext_DatabaseInfo_serverName(ctx, field)
-			case "serverVersion":
-				return ec.fieldContext_DatabaseInfo_serverVersion(ctx, field)
+			case "strategy":
+				return ec.fieldContext_StrategyTiming_strategy(ctx, field)
+			case "durationMs":
+				return ec.fieldContext_StrategyTiming_durationMs(ctx, field)
+			case "results":
+				return ec.fieldContext_StrategyTiming_results(ctx, field)
+			case "total":
+				return ec.fieldContext_StrategyTiming_total(ctx, field)
+			case "error":
+				return ec.fieldContext_StrategyTiming_error(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DatabaseInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StrategyTiming", field.Name)
 		},
 	}
 	return fc, nil
@@ -5331,6 +8355,68 @@ func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputCounterTopInput(ctx context.Context, obj any) (model.CounterTopInput, error) {
+	var it model.CounterTopInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["limit"]; !present {
+		asMap["limit"] = 5
+	}
+	if _, present := asMap["offset"]; !present {
+		asMap["offset"] = 0
+	}
+
+	fieldsInOrder := [...]string{"limit", "offset", "serviceName", "searchType", "sessionId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		case "serviceName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceName = data
+		case "searchType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("searchType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SearchType = data
+		case "sessionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sessionId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SessionID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputExpandableSearchQueryInput(ctx context.Context, obj any) (model.ExpandableSearchQueryInput, error) {
 	var it model.ExpandableSearchQueryInput
 	asMap := map[string]any{}
@@ -5348,7 +8434,7 @@ func (ec *executionContext) unmarshalInputExpandableSearchQueryInput(ctx context
 		asMap["size"] = 10
 	}
 
-	fieldsInOrder := [...]string{"word", "language", "expand", "page", "size"}
+	fieldsInOrder := [...]string{"word", "language", "expand", "page", "size", "after"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5390,6 +8476,13 @@ func (ec *executionContext) unmarshalInputExpandableSearchQueryInput(ctx context
 				return it, err
 			}
 			it.Size = data
+		case "after":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.After = data
 		}
 	}
 
@@ -5413,7 +8506,7 @@ func (ec *executionContext) unmarshalInputSearchQueryInput(ctx context.Context,
 		asMap["size"] = 10
 	}
 
-	fieldsInOrder := [...]string{"word", "language", "page", "size"}
+	fieldsInOrder := [...]string{"word", "language", "page", "size", "after"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -5448,6 +8541,123 @@ func (ec *executionContext) unmarshalInputSearchQueryInput(ctx context.Context,
 				return it, err
 			}
 			it.Size = data
+		case "after":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.After = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUnifiedSearchInput(ctx context.Context, obj any) (model.UnifiedSearchInput, error) {
+	var it model.UnifiedSearchInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["language"]; !present {
+		asMap["language"] = "LANG_GREEK"
+	}
+	if _, present := asMap["size"]; !present {
+		asMap["size"] = 10
+	}
+
+	fieldsInOrder := [...]string{"word", "language", "size", "strategies"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "word":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("word"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Word = data
+		case "language":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("language"))
+			data, err := ec.unmarshalOLanguage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLanguage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Language = data
+		case "size":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("size"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Size = data
+		case "strategies":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("strategies"))
+			data, err := ec.unmarshalOSearchStrategy2ᚕgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategyᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Strategies = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputZeroResultsInput(ctx context.Context, obj any) (model.ZeroResultsInput, error) {
+	var it model.ZeroResultsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["limit"]; !present {
+		asMap["limit"] = 5
+	}
+	if _, present := asMap["offset"]; !present {
+		asMap["offset"] = 0
+	}
+
+	fieldsInOrder := [...]string{"limit", "offset", "serviceName", "language"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "offset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Offset = data
+		case "serviceName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("serviceName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ServiceName = data
+		case "language":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("language"))
+			data, err := ec.unmarshalOLanguage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLanguage(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Language = data
 		}
 	}
 
@@ -5478,6 +8688,11 @@ func (ec *executionContext) _AggregatedHealthResponse(ctx context.Context, sel a
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "degraded":
+			out.Values[i] = ec._AggregatedHealthResponse_degraded(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "time":
 			out.Values[i] = ec._AggregatedHealthResponse_time(ctx, field, obj)
 		case "version":
@@ -5487,6 +8702,10 @@ func (ec *executionContext) _AggregatedHealthResponse(ctx context.Context, sel a
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "cache":
+			out.Values[i] = ec._AggregatedHealthResponse_cache(ctx, field, obj)
+		case "ingester":
+			out.Values[i] = ec._AggregatedHealthResponse_ingester(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5554,23 +8773,125 @@ func (ec *executionContext) _AnalyzeResult(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var analyzeTextResponseImplementors = []string{"AnalyzeTextResponse"}
+var analyzeTextResponseImplementors = []string{"AnalyzeTextResponse"}
+
+func (ec *executionContext) _AnalyzeTextResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AnalyzeTextResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, analyzeTextResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AnalyzeTextResponse")
+		case "conjugations":
+			out.Values[i] = ec._AnalyzeTextResponse_conjugations(ctx, field, obj)
+		case "texts":
+			out.Values[i] = ec._AnalyzeTextResponse_texts(ctx, field, obj)
+		case "rootword":
+			out.Values[i] = ec._AnalyzeTextResponse_rootword(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cacheHealthImplementors = []string{"CacheHealth"}
+
+func (ec *executionContext) _CacheHealth(ctx context.Context, sel ast.SelectionSet, obj *model.CacheHealth) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cacheHealthImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CacheHealth")
+		case "backend":
+			out.Values[i] = ec._CacheHealth_backend(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entries":
+			out.Values[i] = ec._CacheHealth_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hits":
+			out.Values[i] = ec._CacheHealth_hits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "misses":
+			out.Values[i] = ec._CacheHealth_misses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "evictions":
+			out.Values[i] = ec._CacheHealth_evictions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "purges":
+			out.Values[i] = ec._CacheHealth_purges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var conjugationResponseImplementors = []string{"ConjugationResponse"}
 
-func (ec *executionContext) _AnalyzeTextResponse(ctx context.Context, sel ast.SelectionSet, obj *model.AnalyzeTextResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, analyzeTextResponseImplementors)
+func (ec *executionContext) _ConjugationResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ConjugationResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, conjugationResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AnalyzeTextResponse")
-		case "conjugations":
-			out.Values[i] = ec._AnalyzeTextResponse_conjugations(ctx, field, obj)
-		case "texts":
-			out.Values[i] = ec._AnalyzeTextResponse_texts(ctx, field, obj)
-		case "rootword":
-			out.Values[i] = ec._AnalyzeTextResponse_rootword(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ConjugationResponse")
+		case "rule":
+			out.Values[i] = ec._ConjugationResponse_rule(ctx, field, obj)
+		case "word":
+			out.Values[i] = ec._ConjugationResponse_word(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5594,21 +8915,31 @@ func (ec *executionContext) _AnalyzeTextResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var conjugationResponseImplementors = []string{"ConjugationResponse"}
+var connectionPageInfoImplementors = []string{"ConnectionPageInfo"}
 
-func (ec *executionContext) _ConjugationResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ConjugationResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, conjugationResponseImplementors)
+func (ec *executionContext) _ConnectionPageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.ConnectionPageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, connectionPageInfoImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ConjugationResponse")
-		case "rule":
-			out.Values[i] = ec._ConjugationResponse_rule(ctx, field, obj)
-		case "word":
-			out.Values[i] = ec._ConjugationResponse_word(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ConnectionPageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._ConnectionPageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._ConnectionPageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startCursor":
+			out.Values[i] = ec._ConnectionPageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._ConnectionPageInfo_endCursor(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5721,6 +9052,50 @@ func (ec *executionContext) _Definition(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var dictionaryEventImplementors = []string{"DictionaryEvent"}
+
+func (ec *executionContext) _DictionaryEvent(ctx context.Context, sel ast.SelectionSet, obj *model.DictionaryEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dictionaryEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DictionaryEvent")
+		case "status":
+			out.Values[i] = ec._DictionaryEvent_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._DictionaryEvent_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var eukleidesTopFiveImplementors = []string{"EukleidesTopFive"}
 
 func (ec *executionContext) _EukleidesTopFive(ctx context.Context, sel ast.SelectionSet, obj *model.EukleidesTopFive) graphql.Marshaler {
@@ -5811,6 +9186,60 @@ func (ec *executionContext) _EukleidesTopFiveResponse(ctx context.Context, sel a
 	return out
 }
 
+var eukleidesTopPageImplementors = []string{"EukleidesTopPage"}
+
+func (ec *executionContext) _EukleidesTopPage(ctx context.Context, sel ast.SelectionSet, obj *model.EukleidesTopPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, eukleidesTopPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EukleidesTopPage")
+		case "entries":
+			out.Values[i] = ec._EukleidesTopPage_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._EukleidesTopPage_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "limit":
+			out.Values[i] = ec._EukleidesTopPage_limit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "offset":
+			out.Values[i] = ec._EukleidesTopPage_offset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var extendedResponseImplementors = []string{"ExtendedResponse"}
 
 func (ec *executionContext) _ExtendedResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ExtendedResponse) graphql.Marshaler {
@@ -5869,16 +9298,189 @@ func (ec *executionContext) _HealthResponse(ctx context.Context, sel ast.Selecti
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HealthResponse")
-		case "healthy":
-			out.Values[i] = ec._HealthResponse_healthy(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("HealthResponse")
+		case "healthy":
+			out.Values[i] = ec._HealthResponse_healthy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._HealthResponse_time(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._HealthResponse_version(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var hitImplementors = []string{"Hit"}
+
+func (ec *executionContext) _Hit(ctx context.Context, sel ast.SelectionSet, obj *model.Hit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Hit")
+		case "dutch":
+			out.Values[i] = ec._Hit_dutch(ctx, field, obj)
+		case "english":
+			out.Values[i] = ec._Hit_english(ctx, field, obj)
+		case "greek":
+			out.Values[i] = ec._Hit_greek(ctx, field, obj)
+		case "linkedWord":
+			out.Values[i] = ec._Hit_linkedWord(ctx, field, obj)
+		case "original":
+			out.Values[i] = ec._Hit_original(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var ingesterHealthImplementors = []string{"IngesterHealth"}
+
+func (ec *executionContext) _IngesterHealth(ctx context.Context, sel ast.SelectionSet, obj *model.IngesterHealth) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ingesterHealthImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IngesterHealth")
+		case "sent":
+			out.Values[i] = ec._IngesterHealth_sent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dropped":
+			out.Values[i] = ec._IngesterHealth_dropped(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "spilled":
+			out.Values[i] = ec._IngesterHealth_spilled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reconnects":
+			out.Values[i] = ec._IngesterHealth_reconnects(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queued":
+			out.Values[i] = ec._IngesterHealth_queued(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var lemmaImplementors = []string{"Lemma"}
+
+func (ec *executionContext) _Lemma(ctx context.Context, sel ast.SelectionSet, obj *model.Lemma) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lemmaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Lemma")
+		case "id":
+			out.Values[i] = ec._Lemma_id(ctx, field, obj)
+		case "headword":
+			out.Values[i] = ec._Lemma_headword(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "normalized":
+			out.Values[i] = ec._Lemma_normalized(ctx, field, obj)
+		case "linkedWord":
+			out.Values[i] = ec._Lemma_linkedWord(ctx, field, obj)
+		case "partOfSpeech":
+			out.Values[i] = ec._Lemma_partOfSpeech(ctx, field, obj)
+		case "article":
+			out.Values[i] = ec._Lemma_article(ctx, field, obj)
+		case "gender":
+			out.Values[i] = ec._Lemma_gender(ctx, field, obj)
+		case "noun":
+			out.Values[i] = ec._Lemma_noun(ctx, field, obj)
+		case "verb":
+			out.Values[i] = ec._Lemma_verb(ctx, field, obj)
+		case "quickGlosses":
+			out.Values[i] = ec._Lemma_quickGlosses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "definitions":
+			out.Values[i] = ec._Lemma_definitions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "modernConnections":
+			out.Values[i] = ec._Lemma_modernConnections(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "time":
-			out.Values[i] = ec._HealthResponse_time(ctx, field, obj)
-		case "version":
-			out.Values[i] = ec._HealthResponse_version(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5902,27 +9504,32 @@ func (ec *executionContext) _HealthResponse(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var hitImplementors = []string{"Hit"}
+var lemmaConnectionImplementors = []string{"LemmaConnection"}
 
-func (ec *executionContext) _Hit(ctx context.Context, sel ast.SelectionSet, obj *model.Hit) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, hitImplementors)
+func (ec *executionContext) _LemmaConnection(ctx context.Context, sel ast.SelectionSet, obj *model.LemmaConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lemmaConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Hit")
-		case "dutch":
-			out.Values[i] = ec._Hit_dutch(ctx, field, obj)
-		case "english":
-			out.Values[i] = ec._Hit_english(ctx, field, obj)
-		case "greek":
-			out.Values[i] = ec._Hit_greek(ctx, field, obj)
-		case "linkedWord":
-			out.Values[i] = ec._Hit_linkedWord(ctx, field, obj)
-		case "original":
-			out.Values[i] = ec._Hit_original(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LemmaConnection")
+		case "edges":
+			out.Values[i] = ec._LemmaConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._LemmaConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCount":
+			out.Values[i] = ec._LemmaConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5946,50 +9553,24 @@ func (ec *executionContext) _Hit(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var lemmaImplementors = []string{"Lemma"}
+var lemmaEdgeImplementors = []string{"LemmaEdge"}
 
-func (ec *executionContext) _Lemma(ctx context.Context, sel ast.SelectionSet, obj *model.Lemma) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, lemmaImplementors)
+func (ec *executionContext) _LemmaEdge(ctx context.Context, sel ast.SelectionSet, obj *model.LemmaEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lemmaEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Lemma")
-		case "id":
-			out.Values[i] = ec._Lemma_id(ctx, field, obj)
-		case "headword":
-			out.Values[i] = ec._Lemma_headword(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "normalized":
-			out.Values[i] = ec._Lemma_normalized(ctx, field, obj)
-		case "linkedWord":
-			out.Values[i] = ec._Lemma_linkedWord(ctx, field, obj)
-		case "partOfSpeech":
-			out.Values[i] = ec._Lemma_partOfSpeech(ctx, field, obj)
-		case "article":
-			out.Values[i] = ec._Lemma_article(ctx, field, obj)
-		case "gender":
-			out.Values[i] = ec._Lemma_gender(ctx, field, obj)
-		case "noun":
-			out.Values[i] = ec._Lemma_noun(ctx, field, obj)
-		case "verb":
-			out.Values[i] = ec._Lemma_verb(ctx, field, obj)
-		case "quickGlosses":
-			out.Values[i] = ec._Lemma_quickGlosses(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "definitions":
-			out.Values[i] = ec._Lemma_definitions(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LemmaEdge")
+		case "node":
+			out.Values[i] = ec._LemmaEdge_node(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "modernConnections":
-			out.Values[i] = ec._Lemma_modernConnections(ctx, field, obj)
+		case "cursor":
+			out.Values[i] = ec._LemmaEdge_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -6216,6 +9797,13 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nextCursor":
+			out.Values[i] = ec._PageInfo_nextCursor(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6236,29 +9824,205 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 		})
 	}
 
-	return out
-}
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "health":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_health(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "counterTopFive":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_counterTopFive(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "counterService":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_counterService(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "counterSession":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_counterSession(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "counterTop":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_counterTop(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "counterZeroResults":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_counterZeroResults(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "trending":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_trending(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-var queryImplementors = []string{"Query"}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "text":
+			field := field
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_text(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "health":
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "fuzzy":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6267,7 +10031,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_health(ctx, field)
+				res = ec._Query_fuzzy(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6280,7 +10044,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "counterTopFive":
+		case "exact":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6289,7 +10053,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_counterTopFive(ctx, field)
+				res = ec._Query_exact(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6302,7 +10066,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "counterService":
+		case "phrase":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6311,7 +10075,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_counterService(ctx, field)
+				res = ec._Query_phrase(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6324,7 +10088,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "counterSession":
+		case "partial":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6333,7 +10097,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_counterSession(ctx, field)
+				res = ec._Query_partial(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6346,7 +10110,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "text":
+		case "search":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6355,7 +10119,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_text(ctx, field)
+				res = ec._Query_search(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6368,7 +10132,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "fuzzy":
+		case "exactConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6377,7 +10141,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_fuzzy(ctx, field)
+				res = ec._Query_exactConnection(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6390,7 +10154,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "exact":
+		case "fuzzyConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6399,7 +10163,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_exact(ctx, field)
+				res = ec._Query_fuzzyConnection(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6412,7 +10176,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "phrase":
+		case "phraseConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6421,7 +10185,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_phrase(ctx, field)
+				res = ec._Query_phraseConnection(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6434,7 +10198,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "partial":
+		case "partialConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -6443,7 +10207,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_partial(ctx, field)
+				res = ec._Query_partialConnection(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -6487,24 +10251,34 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var referenceImplementors = []string{"Reference"}
+var rankedLemmaImplementors = []string{"RankedLemma"}
 
-func (ec *executionContext) _Reference(ctx context.Context, sel ast.SelectionSet, obj *model.Reference) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, referenceImplementors)
+func (ec *executionContext) _RankedLemma(ctx context.Context, sel ast.SelectionSet, obj *model.RankedLemma) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rankedLemmaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Reference")
-		case "work":
-			out.Values[i] = ec._Reference_work(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RankedLemma")
+		case "rank":
+			out.Values[i] = ec._RankedLemma_rank(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lemma":
+			out.Values[i] = ec._RankedLemma_lemma(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "strategy":
+			out.Values[i] = ec._RankedLemma_strategy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "locus":
-			out.Values[i] = ec._Reference_locus(ctx, field, obj)
+		case "foundBy":
+			out.Values[i] = ec._RankedLemma_foundBy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -6571,27 +10345,284 @@ func (ec *executionContext) _Rhema(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var searchResponseImplementors = []string{"SearchResponse"}
+var searchEventImplementors = []string{"SearchEvent"}
+
+func (ec *executionContext) _SearchEvent(ctx context.Context, sel ast.SelectionSet, obj *model.SearchEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchEvent")
+		case "word":
+			out.Values[i] = ec._SearchEvent_word(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "serviceName":
+			out.Values[i] = ec._SearchEvent_serviceName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "searchType":
+			out.Values[i] = ec._SearchEvent_searchType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "language":
+			out.Values[i] = ec._SearchEvent_language(ctx, field, obj)
+		case "resultCount":
+			out.Values[i] = ec._SearchEvent_resultCount(ctx, field, obj)
+		case "time":
+			out.Values[i] = ec._SearchEvent_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var searchResponseImplementors = []string{"SearchResponse"}
+
+func (ec *executionContext) _SearchResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SearchResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, searchResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SearchResponse")
+		case "results":
+			out.Values[i] = ec._SearchResponse_results(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._SearchResponse_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var serviceHealthImplementors = []string{"ServiceHealth"}
+
+func (ec *executionContext) _ServiceHealth(ctx context.Context, sel ast.SelectionSet, obj *model.ServiceHealth) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, serviceHealthImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ServiceHealth")
+		case "name":
+			out.Values[i] = ec._ServiceHealth_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "healthy":
+			out.Values[i] = ec._ServiceHealth_healthy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "critical":
+			out.Values[i] = ec._ServiceHealth_critical(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "latencyMs":
+			out.Values[i] = ec._ServiceHealth_latencyMs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "error":
+			out.Values[i] = ec._ServiceHealth_error(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._ServiceHealth_version(ctx, field, obj)
+		case "databaseInfo":
+			out.Values[i] = ec._ServiceHealth_databaseInfo(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var strategyTimingImplementors = []string{"StrategyTiming"}
+
+func (ec *executionContext) _StrategyTiming(ctx context.Context, sel ast.SelectionSet, obj *model.StrategyTiming) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, strategyTimingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StrategyTiming")
+		case "strategy":
+			out.Values[i] = ec._StrategyTiming_strategy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "durationMs":
+			out.Values[i] = ec._StrategyTiming_durationMs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "results":
+			out.Values[i] = ec._StrategyTiming_results(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._StrategyTiming_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "error":
+			out.Values[i] = ec._StrategyTiming_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
 
-func (ec *executionContext) _SearchResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SearchResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, searchResponseImplementors)
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		graphql.AddErrorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "searches":
+		return ec._Subscription_searches(ctx, fields[0])
+	case "dictionary":
+		return ec._Subscription_dictionary(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var trendingWordImplementors = []string{"TrendingWord"}
+
+func (ec *executionContext) _TrendingWord(ctx context.Context, sel ast.SelectionSet, obj *model.TrendingWord) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, trendingWordImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SearchResponse")
-		case "results":
-			out.Values[i] = ec._SearchResponse_results(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TrendingWord")
+		case "serviceName":
+			out.Values[i] = ec._TrendingWord_serviceName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageInfo":
-			out.Values[i] = ec._SearchResponse_pageInfo(ctx, field, obj)
+		case "word":
+			out.Values[i] = ec._TrendingWord_word(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recentCount":
+			out.Values[i] = ec._TrendingWord_recentCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "baselineAverage":
+			out.Values[i] = ec._TrendingWord_baselineAverage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._TrendingWord_score(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "lastUsed":
+			out.Values[i] = ec._TrendingWord_lastUsed(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6615,31 +10646,27 @@ func (ec *executionContext) _SearchResponse(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var serviceHealthImplementors = []string{"ServiceHealth"}
+var unifiedSearchResponseImplementors = []string{"UnifiedSearchResponse"}
 
-func (ec *executionContext) _ServiceHealth(ctx context.Context, sel ast.SelectionSet, obj *model.ServiceHealth) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, serviceHealthImplementors)
+func (ec *executionContext) _UnifiedSearchResponse(ctx context.Context, sel ast.SelectionSet, obj *model.UnifiedSearchResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, unifiedSearchResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ServiceHealth")
-		case "name":
-			out.Values[i] = ec._ServiceHealth_name(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UnifiedSearchResponse")
+		case "results":
+			out.Values[i] = ec._UnifiedSearchResponse_results(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "healthy":
-			out.Values[i] = ec._ServiceHealth_healthy(ctx, field, obj)
+		case "timings":
+			out.Values[i] = ec._UnifiedSearchResponse_timings(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "version":
-			out.Values[i] = ec._ServiceHealth_version(ctx, field, obj)
-		case "databaseInfo":
-			out.Values[i] = ec._ServiceHealth_databaseInfo(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7019,46 +11046,271 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 		return graphql.Null
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAggregatedHealthResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAggregatedHealthResponse(ctx context.Context, sel ast.SelectionSet, v model.AggregatedHealthResponse) graphql.Marshaler {
+	return ec._AggregatedHealthResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAggregatedHealthResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAggregatedHealthResponse(ctx context.Context, sel ast.SelectionSet, v *model.AggregatedHealthResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AggregatedHealthResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNConnectionPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐConnectionPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.ConnectionPageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ConnectionPageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDefinition2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinitionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Definition) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDefinition2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinition(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDefinition2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinition(ctx context.Context, sel ast.SelectionSet, v *model.Definition) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Definition(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDictionaryEvent2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDictionaryEvent(ctx context.Context, sel ast.SelectionSet, v model.DictionaryEvent) graphql.Marshaler {
+	return ec._DictionaryEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDictionaryEvent2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDictionaryEvent(ctx context.Context, sel ast.SelectionSet, v *model.DictionaryEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DictionaryEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDictionaryStatus2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDictionaryStatus(ctx context.Context, v any) (model.DictionaryStatus, error) {
+	var res model.DictionaryStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDictionaryStatus2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDictionaryStatus(ctx context.Context, sel ast.SelectionSet, v model.DictionaryStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNEukleidesTopFive2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive(ctx context.Context, sel ast.SelectionSet, v model.EukleidesTopFive) graphql.Marshaler {
+	return ec._EukleidesTopFive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.EukleidesTopFive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNEukleidesTopFive2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNEukleidesTopFive2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive(ctx context.Context, sel ast.SelectionSet, v *model.EukleidesTopFive) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EukleidesTopFive(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNEukleidesTopFiveResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse(ctx context.Context, sel ast.SelectionSet, v model.EukleidesTopFiveResponse) graphql.Marshaler {
+	return ec._EukleidesTopFiveResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEukleidesTopFiveResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse(ctx context.Context, sel ast.SelectionSet, v *model.EukleidesTopFiveResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EukleidesTopFiveResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNEukleidesTopPage2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopPage(ctx context.Context, sel ast.SelectionSet, v model.EukleidesTopPage) graphql.Marshaler {
+	return ec._EukleidesTopPage(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNEukleidesTopPage2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopPage(ctx context.Context, sel ast.SelectionSet, v *model.EukleidesTopPage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._EukleidesTopPage(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) unmarshalNExpandableSearchQueryInput2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExpandableSearchQueryInput(ctx context.Context, v any) (model.ExpandableSearchQueryInput, error) {
+	res, err := ec.unmarshalInputExpandableSearchQueryInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) marshalNAggregatedHealthResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAggregatedHealthResponse(ctx context.Context, sel ast.SelectionSet, v model.AggregatedHealthResponse) graphql.Marshaler {
-	return ec._AggregatedHealthResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNExtendedResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse(ctx context.Context, sel ast.SelectionSet, v model.ExtendedResponse) graphql.Marshaler {
+	return ec._ExtendedResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAggregatedHealthResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐAggregatedHealthResponse(ctx context.Context, sel ast.SelectionSet, v *model.AggregatedHealthResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNExtendedResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse(ctx context.Context, sel ast.SelectionSet, v *model.ExtendedResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AggregatedHealthResponse(ctx, sel, v)
+	return ec._ExtendedResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalBoolean(v)
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalNInt2int32(ctx context.Context, v any) (int32, error) {
+	res, err := graphql.UnmarshalInt32(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int32(ctx context.Context, sel ast.SelectionSet, v int32) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt32(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
@@ -7067,7 +11319,7 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
-func (ec *executionContext) marshalNDefinition2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinitionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Definition) graphql.Marshaler {
+func (ec *executionContext) marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Lemma) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7091,7 +11343,7 @@ func (ec *executionContext) marshalNDefinition2ᚕᚖgithubᚗcomᚋodysseiaᚑg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDefinition2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinition(ctx, sel, v[i])
+			ret[i] = ec.marshalNLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemma(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7111,21 +11363,31 @@ func (ec *executionContext) marshalNDefinition2ᚕᚖgithubᚗcomᚋodysseiaᚑg
 	return ret
 }
 
-func (ec *executionContext) marshalNDefinition2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDefinition(ctx context.Context, sel ast.SelectionSet, v *model.Definition) graphql.Marshaler {
+func (ec *executionContext) marshalNLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemma(ctx context.Context, sel ast.SelectionSet, v *model.Lemma) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Definition(ctx, sel, v)
+	return ec._Lemma(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNEukleidesTopFive2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive(ctx context.Context, sel ast.SelectionSet, v model.EukleidesTopFive) graphql.Marshaler {
-	return ec._EukleidesTopFive(ctx, sel, &v)
+func (ec *executionContext) marshalNLemmaConnection2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaConnection(ctx context.Context, sel ast.SelectionSet, v model.LemmaConnection) graphql.Marshaler {
+	return ec._LemmaConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.EukleidesTopFive) graphql.Marshaler {
+func (ec *executionContext) marshalNLemmaConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaConnection(ctx context.Context, sel ast.SelectionSet, v *model.LemmaConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LemmaConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNLemmaEdge2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LemmaEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7149,7 +11411,7 @@ func (ec *executionContext) marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysse
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNEukleidesTopFive2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive(ctx, sel, v[i])
+			ret[i] = ec.marshalNLemmaEdge2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaEdge(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7169,66 +11431,189 @@ func (ec *executionContext) marshalNEukleidesTopFive2ᚕᚖgithubᚗcomᚋodysse
 	return ret
 }
 
-func (ec *executionContext) marshalNEukleidesTopFive2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFive(ctx context.Context, sel ast.SelectionSet, v *model.EukleidesTopFive) graphql.Marshaler {
+func (ec *executionContext) marshalNLemmaEdge2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaEdge(ctx context.Context, sel ast.SelectionSet, v *model.LemmaEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._EukleidesTopFive(ctx, sel, v)
+	return ec._LemmaEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNEukleidesTopFiveResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse(ctx context.Context, sel ast.SelectionSet, v model.EukleidesTopFiveResponse) graphql.Marshaler {
-	return ec._EukleidesTopFiveResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNLocalizedGloss2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGlossᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LocalizedGloss) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLocalizedGloss2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGloss(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNEukleidesTopFiveResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐEukleidesTopFiveResponse(ctx context.Context, sel ast.SelectionSet, v *model.EukleidesTopFiveResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNLocalizedGloss2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGloss(ctx context.Context, sel ast.SelectionSet, v *model.LocalizedGloss) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._EukleidesTopFiveResponse(ctx, sel, v)
+	return ec._LocalizedGloss(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNExpandableSearchQueryInput2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExpandableSearchQueryInput(ctx context.Context, v any) (model.ExpandableSearchQueryInput, error) {
-	res, err := ec.unmarshalInputExpandableSearchQueryInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalNMeaning2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaningᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Meaning) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMeaning2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaning(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNExtendedResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse(ctx context.Context, sel ast.SelectionSet, v model.ExtendedResponse) graphql.Marshaler {
-	return ec._ExtendedResponse(ctx, sel, &v)
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNExtendedResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐExtendedResponse(ctx context.Context, sel ast.SelectionSet, v *model.ExtendedResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNMeaning2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaning(ctx context.Context, sel ast.SelectionSet, v *model.Meaning) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ExtendedResponse(ctx, sel, v)
+	return ec._Meaning(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int32(ctx context.Context, v any) (int32, error) {
-	res, err := graphql.UnmarshalInt32(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNModernConnection2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnectionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ModernConnection) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNModernConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnection(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2int32(ctx context.Context, sel ast.SelectionSet, v int32) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt32(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNModernConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnection(ctx context.Context, sel ast.SelectionSet, v *model.ModernConnection) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._ModernConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemmaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Lemma) graphql.Marshaler {
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRankedLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐRankedLemmaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RankedLemma) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7252,7 +11637,7 @@ func (ec *executionContext) marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreek
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemma(ctx, sel, v[i])
+			ret[i] = ec.marshalNRankedLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐRankedLemma(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7261,28 +11646,86 @@ func (ec *executionContext) marshalNLemma2ᚕᚖgithubᚗcomᚋodysseiaᚑgreek
 		}
 
 	}
-	wg.Wait()
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRankedLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐRankedLemma(ctx context.Context, sel ast.SelectionSet, v *model.RankedLemma) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RankedLemma(ctx, sel, v)
+}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+func (ec *executionContext) marshalNSearchEvent2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchEvent(ctx context.Context, sel ast.SelectionSet, v model.SearchEvent) graphql.Marshaler {
+	return ec._SearchEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSearchEvent2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchEvent(ctx context.Context, sel ast.SelectionSet, v *model.SearchEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
+	return ec._SearchEvent(ctx, sel, v)
+}
 
-	return ret
+func (ec *executionContext) unmarshalNSearchQueryInput2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchQueryInput(ctx context.Context, v any) (model.SearchQueryInput, error) {
+	res, err := ec.unmarshalInputSearchQueryInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNLemma2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLemma(ctx context.Context, sel ast.SelectionSet, v *model.Lemma) graphql.Marshaler {
+func (ec *executionContext) marshalNSearchResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse(ctx context.Context, sel ast.SelectionSet, v model.SearchResponse) graphql.Marshaler {
+	return ec._SearchResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse(ctx context.Context, sel ast.SelectionSet, v *model.SearchResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Lemma(ctx, sel, v)
+	return ec._SearchResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNLocalizedGloss2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGlossᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LocalizedGloss) graphql.Marshaler {
+func (ec *executionContext) unmarshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy(ctx context.Context, v any) (model.SearchStrategy, error) {
+	var res model.SearchStrategy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy(ctx context.Context, sel ast.SelectionSet, v model.SearchStrategy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSearchStrategy2ᚕgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategyᚄ(ctx context.Context, v any) ([]model.SearchStrategy, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SearchStrategy, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNSearchStrategy2ᚕgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategyᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SearchStrategy) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7306,7 +11749,7 @@ func (ec *executionContext) marshalNLocalizedGloss2ᚕᚖgithubᚗcomᚋodysseia
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLocalizedGloss2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGloss(ctx, sel, v[i])
+			ret[i] = ec.marshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7326,17 +11769,7 @@ func (ec *executionContext) marshalNLocalizedGloss2ᚕᚖgithubᚗcomᚋodysseia
 	return ret
 }
 
-func (ec *executionContext) marshalNLocalizedGloss2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐLocalizedGloss(ctx context.Context, sel ast.SelectionSet, v *model.LocalizedGloss) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._LocalizedGloss(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNMeaning2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaningᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Meaning) graphql.Marshaler {
+func (ec *executionContext) marshalNServiceHealth2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐServiceHealthᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ServiceHealth) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7360,7 +11793,7 @@ func (ec *executionContext) marshalNMeaning2ᚕᚖgithubᚗcomᚋodysseiaᚑgree
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMeaning2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaning(ctx, sel, v[i])
+			ret[i] = ec.marshalNServiceHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐServiceHealth(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7380,17 +11813,17 @@ func (ec *executionContext) marshalNMeaning2ᚕᚖgithubᚗcomᚋodysseiaᚑgree
 	return ret
 }
 
-func (ec *executionContext) marshalNMeaning2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐMeaning(ctx context.Context, sel ast.SelectionSet, v *model.Meaning) graphql.Marshaler {
+func (ec *executionContext) marshalNServiceHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐServiceHealth(ctx context.Context, sel ast.SelectionSet, v *model.ServiceHealth) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Meaning(ctx, sel, v)
+	return ec._ServiceHealth(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNModernConnection2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnectionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ModernConnection) graphql.Marshaler {
+func (ec *executionContext) marshalNStrategyTiming2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐStrategyTimingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.StrategyTiming) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7414,7 +11847,7 @@ func (ec *executionContext) marshalNModernConnection2ᚕᚖgithubᚗcomᚋodysse
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNModernConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnection(ctx, sel, v[i])
+			ret[i] = ec.marshalNStrategyTiming2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐStrategyTiming(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7434,46 +11867,63 @@ func (ec *executionContext) marshalNModernConnection2ᚕᚖgithubᚗcomᚋodysse
 	return ret
 }
 
-func (ec *executionContext) marshalNModernConnection2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐModernConnection(ctx context.Context, sel ast.SelectionSet, v *model.ModernConnection) graphql.Marshaler {
+func (ec *executionContext) marshalNStrategyTiming2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐStrategyTiming(ctx context.Context, sel ast.SelectionSet, v *model.StrategyTiming) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ModernConnection(ctx, sel, v)
+	return ec._StrategyTiming(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
 	}
-	return ec._PageInfo(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) unmarshalNSearchQueryInput2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchQueryInput(ctx context.Context, v any) (model.SearchQueryInput, error) {
-	res, err := ec.unmarshalInputSearchQueryInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNSearchResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse(ctx context.Context, sel ast.SelectionSet, v model.SearchResponse) graphql.Marshaler {
-	return ec._SearchResponse(ctx, sel, &v)
-}
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
 
-func (ec *executionContext) marshalNSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchResponse(ctx context.Context, sel ast.SelectionSet, v *model.SearchResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._SearchResponse(ctx, sel, v)
+
+	return ret
 }
 
-func (ec *executionContext) marshalNServiceHealth2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐServiceHealthᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ServiceHealth) graphql.Marshaler {
+func (ec *executionContext) marshalNTrendingWord2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐTrendingWordᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TrendingWord) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7497,7 +11947,7 @@ func (ec *executionContext) marshalNServiceHealth2ᚕᚖgithubᚗcomᚋodysseia
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNServiceHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐServiceHealth(ctx, sel, v[i])
+			ret[i] = ec.marshalNTrendingWord2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐTrendingWord(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7517,60 +11967,33 @@ func (ec *executionContext) marshalNServiceHealth2ᚕᚖgithubᚗcomᚋodysseia
 	return ret
 }
 
-func (ec *executionContext) marshalNServiceHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐServiceHealth(ctx context.Context, sel ast.SelectionSet, v *model.ServiceHealth) graphql.Marshaler {
+func (ec *executionContext) marshalNTrendingWord2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐTrendingWord(ctx context.Context, sel ast.SelectionSet, v *model.TrendingWord) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ServiceHealth(ctx, sel, v)
+	return ec._TrendingWord(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalNUnifiedSearchInput2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐUnifiedSearchInput(ctx context.Context, v any) (model.UnifiedSearchInput, error) {
+	res, err := ec.unmarshalInputUnifiedSearchInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
+func (ec *executionContext) marshalNUnifiedSearchResponse2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐUnifiedSearchResponse(ctx context.Context, sel ast.SelectionSet, v model.UnifiedSearchResponse) graphql.Marshaler {
+	return ec._UnifiedSearchResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
-	}
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+func (ec *executionContext) marshalNUnifiedSearchResponse2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐUnifiedSearchResponse(ctx context.Context, sel ast.SelectionSet, v *model.UnifiedSearchResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-
-	return ret
+	return ec._UnifiedSearchResponse(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
@@ -7911,6 +12334,13 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalOCacheHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCacheHealth(ctx context.Context, sel ast.SelectionSet, v *model.CacheHealth) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CacheHealth(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOConjugationResponse2ᚕᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐConjugationResponse(ctx context.Context, sel ast.SelectionSet, v []*model.ConjugationResponse) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -7959,6 +12389,30 @@ func (ec *executionContext) marshalOConjugationResponse2ᚖgithubᚗcomᚋodysse
 	return ec._ConjugationResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOCounterTopInput2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterTopInput(ctx context.Context, v any) (*model.CounterTopInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputCounterTopInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOCounterWindow2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterWindow(ctx context.Context, v any) (*model.CounterWindow, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CounterWindow)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCounterWindow2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐCounterWindow(ctx context.Context, sel ast.SelectionSet, v *model.CounterWindow) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalODatabaseInfo2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐDatabaseInfo(ctx context.Context, sel ast.SelectionSet, v *model.DatabaseInfo) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8014,6 +12468,13 @@ func (ec *executionContext) marshalOHit2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmak
 	return ec._Hit(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOIngesterHealth2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐIngesterHealth(ctx context.Context, sel ast.SelectionSet, v *model.IngesterHealth) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._IngesterHealth(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint32(ctx context.Context, v any) (*int32, error) {
 	if v == nil {
 		return nil, nil
@@ -8062,6 +12523,71 @@ func (ec *executionContext) marshalORhema2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋm
 	return ec._Rhema(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOSearchStrategy2ᚕgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategyᚄ(ctx context.Context, v any) ([]model.SearchStrategy, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SearchStrategy, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOSearchStrategy2ᚕgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategyᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SearchStrategy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSearchStrategy2githubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐSearchStrategy(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v any) ([]*string, error) {
 	if v == nil {
 		return nil, nil
@@ -8117,6 +12643,14 @@ func (ec *executionContext) marshalOVerbInfo2ᚖgithubᚗcomᚋodysseiaᚑgreek
 	return ec._VerbInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOZeroResultsInput2ᚖgithubᚗcomᚋodysseiaᚑgreekᚋmakedoniaᚋalexandrosᚋgraphᚋmodelᚐZeroResultsInput(ctx context.Context, v any) (*model.ZeroResultsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputZeroResultsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
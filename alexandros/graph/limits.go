@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	LimitComplexity string = "complexity"
+	LimitDepth      string = "depth"
+	LimitAlias      string = "alias"
+)
+
+// Limits bound the operations the public endpoint executes. A limit of zero is not enforced.
+//
+// Every field costs one plus its children. A search field adds SearchCost for each backend it reaches, exact with
+// expand adds ExpandCost on top since it also calls Antigonos and Ptolemaios, and text adds TextCost.
+type Limits struct {
+	MaxComplexity int
+	MaxDepth      int
+	MaxAliases    int
+	SearchCost    int
+	ExpandCost    int
+	TextCost      int
+}
+
+func DefaultLimits() Limits {
+	return Limits{
+		MaxComplexity: 300,
+		MaxDepth:      10,
+		MaxAliases:    15,
+		SearchCost:    10,
+		ExpandCost:    30,
+		TextCost:      30,
+	}
+}
+
+// Complexity scores the query fields that call a backend, the generated default is used for everything else.
+func (l Limits) Complexity() ComplexityRoot {
+	var c ComplexityRoot
+
+	search := func(childComplexity int) int {
+		return 1 + l.SearchCost + childComplexity
+	}
+	connection := func(childComplexity int, _ string, _ *model.Language, _ *int32, _ *string) int {
+		return search(childComplexity)
+	}
+
+	c.Query.Fuzzy = func(childComplexity int, _ model.SearchQueryInput) int {
+		return search(childComplexity)
+	}
+	c.Query.Phrase = func(childComplexity int, _ model.SearchQueryInput) int {
+		return search(childComplexity)
+	}
+	c.Query.Partial = func(childComplexity int, _ model.SearchQueryInput) int {
+		return search(childComplexity)
+	}
+	c.Query.Exact = func(childComplexity int, input model.ExpandableSearchQueryInput) int {
+		if input.Expand {
+			return search(childComplexity) + l.ExpandCost
+		}
+		return search(childComplexity)
+	}
+	c.Query.Text = func(childComplexity int, _ model.ExpandableSearchQueryInput) int {
+		return 1 + l.TextCost + childComplexity
+	}
+	c.Query.Search = func(childComplexity int, input model.UnifiedSearchInput) int {
+		strategies := len(input.Strategies)
+		if strategies == 0 {
+			strategies = len(model.AllSearchStrategy)
+		}
+		return 1 + strategies*l.SearchCost + childComplexity
+	}
+	c.Query.ExactConnection = connection
+	c.Query.FuzzyConnection = connection
+	c.Query.PhraseConnection = connection
+	c.Query.PartialConnection = connection
+
+	return c
+}
+
+// QueryLimits rejects an operation before execution once it is too complex, too deep or has too many aliases. The
+// error names the limit in extensions.limit next to its maximum and the value the operation reached.
+type QueryLimits struct {
+	Limits Limits
+
+	schema graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &QueryLimits{}
+
+func (q *QueryLimits) ExtensionName() string {
+	return "QueryLimits"
+}
+
+func (q *QueryLimits) Validate(schema graphql.ExecutableSchema) error {
+	q.schema = schema
+	return nil
+}
+
+func (q *QueryLimits) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	operation := opCtx.Operation
+	if operation == nil {
+		return nil
+	}
+
+	if err := exceeds(LimitDepth, q.Limits.MaxDepth, selectionDepth(operation.SelectionSet)); err != nil {
+		return err
+	}
+	if err := exceeds(LimitAlias, q.Limits.MaxAliases, aliasCount(operation.SelectionSet)); err != nil {
+		return err
+	}
+	return exceeds(LimitComplexity, q.Limits.MaxComplexity, complexity.Calculate(ctx, q.schema, operation, opCtx.Variables))
+}
+
+func exceeds(limit string, maximum, actual int) *gqlerror.Error {
+	if maximum <= 0 || actual <= maximum {
+		return nil
+	}
+
+	return &gqlerror.Error{
+		Message: fmt.Sprintf("operation exceeds the %s limit: %d, the maximum is %d", limit, actual, maximum),
+		Extensions: map[string]interface{}{
+			"code":    strings.ToUpper(limit) + "_LIMIT_EXCEEDED",
+			"limit":   limit,
+			"maximum": maximum,
+			"actual":  actual,
+		},
+	}
+}
+
+// selectionDepth is the number of nested fields, fragments add no level of their own. Introspection is left out, the
+// standard introspection query nests far deeper than any search does.
+func selectionDepth(selectionSet ast.SelectionSet) int {
+	deepest := 0
+	for _, selection := range selectionSet {
+		var depth int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionDepth(s.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		}
+
+		if depth > deepest {
+			deepest = depth
+		}
+	}
+	return deepest
+}
+
+// aliasCount counts the fields requested under a name of their own, a fragment counts again every time it is spread.
+func aliasCount(selectionSet ast.SelectionSet) int {
+	count := 0
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if s.Alias != "" && s.Alias != s.Name {
+				count++
+			}
+			count += aliasCount(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				count += aliasCount(s.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			count += aliasCount(s.SelectionSet)
+		}
+	}
+	return count
+}
@@ -11,9 +11,12 @@ import (
 
 type AggregatedHealthResponse struct {
 	Healthy  bool             `json:"healthy"`
+	Degraded bool             `json:"degraded"`
 	Time     *string          `json:"time,omitempty"`
 	Version  *string          `json:"version,omitempty"`
 	Services []*ServiceHealth `json:"services"`
+	Cache    *CacheHealth     `json:"cache,omitempty"`
+	Ingester *IngesterHealth  `json:"ingester,omitempty"`
 }
 
 type AnalyzeResult struct {
@@ -30,11 +33,35 @@ type AnalyzeTextResponse struct {
 	Rootword     *string                `json:"rootword,omitempty"`
 }
 
+type CacheHealth struct {
+	Backend   string `json:"backend"`
+	Entries   int32  `json:"entries"`
+	Hits      int32  `json:"hits"`
+	Misses    int32  `json:"misses"`
+	Evictions int32  `json:"evictions"`
+	Purges    int32  `json:"purges"`
+}
+
 type ConjugationResponse struct {
 	Rule *string `json:"rule,omitempty"`
 	Word *string `json:"word,omitempty"`
 }
 
+type ConnectionPageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+type CounterTopInput struct {
+	Limit       *int32  `json:"limit,omitempty"`
+	Offset      *int32  `json:"offset,omitempty"`
+	ServiceName *string `json:"serviceName,omitempty"`
+	SearchType  *string `json:"searchType,omitempty"`
+	SessionID   *string `json:"sessionId,omitempty"`
+}
+
 type DatabaseInfo struct {
 	Healthy       bool    `json:"healthy"`
 	ClusterName   *string `json:"clusterName,omitempty"`
@@ -47,6 +74,11 @@ type Definition struct {
 	Meanings []*Meaning `json:"meanings"`
 }
 
+type DictionaryEvent struct {
+	Status DictionaryStatus `json:"status"`
+	Time   string           `json:"time"`
+}
+
 type EukleidesTopFive struct {
 	ServiceName string  `json:"serviceName"`
 	Word        string  `json:"word"`
@@ -58,12 +90,20 @@ type EukleidesTopFiveResponse struct {
 	TopFive []*EukleidesTopFive `json:"topFive"`
 }
 
+type EukleidesTopPage struct {
+	Entries []*EukleidesTopFive `json:"entries"`
+	Total   int32               `json:"total"`
+	Limit   int32               `json:"limit"`
+	Offset  int32               `json:"offset"`
+}
+
 type ExpandableSearchQueryInput struct {
 	Word     string    `json:"word"`
 	Language *Language `json:"language,omitempty"`
 	Expand   bool      `json:"expand"`
 	Page     *int32    `json:"page,omitempty"`
 	Size     *int32    `json:"size,omitempty"`
+	After    *string   `json:"after,omitempty"`
 }
 
 type ExtendedResponse struct {
@@ -87,6 +127,14 @@ type Hit struct {
 	Original   *string `json:"original,omitempty"`
 }
 
+type IngesterHealth struct {
+	Sent       int32 `json:"sent"`
+	Dropped    int32 `json:"dropped"`
+	Spilled    int32 `json:"spilled"`
+	Reconnects int32 `json:"reconnects"`
+	Queued     int32 `json:"queued"`
+}
+
 type Lemma struct {
 	ID                *string             `json:"id,omitempty"`
 	Headword          string              `json:"headword"`
@@ -102,6 +150,17 @@ type Lemma struct {
 	ModernConnections []*ModernConnection `json:"modernConnections"`
 }
 
+type LemmaConnection struct {
+	Edges      []*LemmaEdge        `json:"edges"`
+	PageInfo   *ConnectionPageInfo `json:"pageInfo"`
+	TotalCount int32               `json:"totalCount"`
+}
+
+type LemmaEdge struct {
+	Node   *Lemma `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
 type LocalizedGloss struct {
 	Language string `json:"language"`
 	Gloss    string `json:"gloss"`
@@ -125,17 +184,21 @@ type NounInfo struct {
 }
 
 type PageInfo struct {
-	Page  int32 `json:"page"`
-	Size  int32 `json:"size"`
-	Total int32 `json:"total"`
+	Page        int32   `json:"page"`
+	Size        int32   `json:"size"`
+	Total       int32   `json:"total"`
+	HasNextPage bool    `json:"hasNextPage"`
+	NextCursor  *string `json:"nextCursor,omitempty"`
 }
 
 type Query struct {
 }
 
-type Reference struct {
-	Work  string `json:"work"`
-	Locus string `json:"locus"`
+type RankedLemma struct {
+	Rank     int32            `json:"rank"`
+	Lemma    *Lemma           `json:"lemma"`
+	Strategy SearchStrategy   `json:"strategy"`
+	FoundBy  []SearchStrategy `json:"foundBy"`
 }
 
 type Rhema struct {
@@ -144,11 +207,21 @@ type Rhema struct {
 	Translations []*string `json:"translations,omitempty"`
 }
 
+type SearchEvent struct {
+	Word        string  `json:"word"`
+	ServiceName string  `json:"serviceName"`
+	SearchType  string  `json:"searchType"`
+	Language    *string `json:"language,omitempty"`
+	ResultCount *int32  `json:"resultCount,omitempty"`
+	Time        string  `json:"time"`
+}
+
 type SearchQueryInput struct {
 	Word     string    `json:"word"`
 	Language *Language `json:"language,omitempty"`
 	Page     *int32    `json:"page,omitempty"`
 	Size     *int32    `json:"size,omitempty"`
+	After    *string   `json:"after,omitempty"`
 }
 
 type SearchResponse struct {
@@ -159,14 +232,172 @@ type SearchResponse struct {
 type ServiceHealth struct {
 	Name         string        `json:"name"`
 	Healthy      bool          `json:"healthy"`
+	Critical     bool          `json:"critical"`
+	LatencyMs    int32         `json:"latencyMs"`
+	Error        *string       `json:"error,omitempty"`
 	Version      *string       `json:"version,omitempty"`
 	DatabaseInfo *DatabaseInfo `json:"databaseInfo,omitempty"`
 }
 
+type StrategyTiming struct {
+	Strategy   SearchStrategy `json:"strategy"`
+	DurationMs int32          `json:"durationMs"`
+	Results    int32          `json:"results"`
+	Total      int32          `json:"total"`
+	Error      *string        `json:"error,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type TrendingWord struct {
+	ServiceName     string  `json:"serviceName"`
+	Word            string  `json:"word"`
+	RecentCount     int32   `json:"recentCount"`
+	BaselineAverage float64 `json:"baselineAverage"`
+	Score           float64 `json:"score"`
+	LastUsed        *string `json:"lastUsed,omitempty"`
+}
+
+type UnifiedSearchInput struct {
+	Word       string           `json:"word"`
+	Language   *Language        `json:"language,omitempty"`
+	Size       *int32           `json:"size,omitempty"`
+	Strategies []SearchStrategy `json:"strategies,omitempty"`
+}
+
+type UnifiedSearchResponse struct {
+	Results []*RankedLemma    `json:"results"`
+	Timings []*StrategyTiming `json:"timings"`
+}
+
 type VerbInfo struct {
 	PrincipalParts []string `json:"principalParts"`
 }
 
+type ZeroResultsInput struct {
+	Limit       *int32    `json:"limit,omitempty"`
+	Offset      *int32    `json:"offset,omitempty"`
+	ServiceName *string   `json:"serviceName,omitempty"`
+	Language    *Language `json:"language,omitempty"`
+}
+
+type CounterWindow string
+
+const (
+	CounterWindowAllTime  CounterWindow = "ALL_TIME"
+	CounterWindowLastHour CounterWindow = "LAST_HOUR"
+	CounterWindowLast24h  CounterWindow = "LAST_24H"
+	CounterWindowLast7d   CounterWindow = "LAST_7D"
+	CounterWindowLast30d  CounterWindow = "LAST_30D"
+)
+
+var AllCounterWindow = []CounterWindow{
+	CounterWindowAllTime,
+	CounterWindowLastHour,
+	CounterWindowLast24h,
+	CounterWindowLast7d,
+	CounterWindowLast30d,
+}
+
+func (e CounterWindow) IsValid() bool {
+	switch e {
+	case CounterWindowAllTime, CounterWindowLastHour, CounterWindowLast24h, CounterWindowLast7d, CounterWindowLast30d:
+		return true
+	}
+	return false
+}
+
+func (e CounterWindow) String() string {
+	return string(e)
+}
+
+func (e *CounterWindow) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CounterWindow(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CounterWindow", str)
+	}
+	return nil
+}
+
+func (e CounterWindow) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CounterWindow) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CounterWindow) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DictionaryStatus string
+
+const (
+	DictionaryStatusReseedStarted   DictionaryStatus = "RESEED_STARTED"
+	DictionaryStatusReseedCompleted DictionaryStatus = "RESEED_COMPLETED"
+)
+
+var AllDictionaryStatus = []DictionaryStatus{
+	DictionaryStatusReseedStarted,
+	DictionaryStatusReseedCompleted,
+}
+
+func (e DictionaryStatus) IsValid() bool {
+	switch e {
+	case DictionaryStatusReseedStarted, DictionaryStatusReseedCompleted:
+		return true
+	}
+	return false
+}
+
+func (e DictionaryStatus) String() string {
+	return string(e)
+}
+
+func (e *DictionaryStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DictionaryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DictionaryStatus", str)
+	}
+	return nil
+}
+
+func (e DictionaryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DictionaryStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DictionaryStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type Language string
 
 const (
@@ -225,3 +456,62 @@ func (e Language) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type SearchStrategy string
+
+const (
+	SearchStrategyExact   SearchStrategy = "EXACT"
+	SearchStrategyPhrase  SearchStrategy = "PHRASE"
+	SearchStrategyPartial SearchStrategy = "PARTIAL"
+	SearchStrategyFuzzy   SearchStrategy = "FUZZY"
+)
+
+var AllSearchStrategy = []SearchStrategy{
+	SearchStrategyExact,
+	SearchStrategyPhrase,
+	SearchStrategyPartial,
+	SearchStrategyFuzzy,
+}
+
+func (e SearchStrategy) IsValid() bool {
+	switch e {
+	case SearchStrategyExact, SearchStrategyPhrase, SearchStrategyPartial, SearchStrategyFuzzy:
+		return true
+	}
+	return false
+}
+
+func (e SearchStrategy) String() string {
+	return string(e)
+}
+
+func (e *SearchStrategy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchStrategy", str)
+	}
+	return nil
+}
+
+func (e SearchStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SearchStrategy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SearchStrategy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
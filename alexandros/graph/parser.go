@@ -2,10 +2,21 @@ package graph
 
 import (
 	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
 )
 
+const (
+	// defaultPage and defaultSize mirror the defaults of the search inputs, which do not apply to an explicit null
+	defaultPage int32 = 1
+	defaultSize int32 = 10
+)
+
 func parseLanguage(inputLanguage *model.Language) koinos.Language {
+	if inputLanguage == nil {
+		return koinos.Language_LANG_GREEK
+	}
+
 	var language koinos.Language
 	switch *inputLanguage {
 	case model.LanguageLangGreek:
@@ -20,3 +31,107 @@ func parseLanguage(inputLanguage *model.Language) koinos.Language {
 
 	return language
 }
+
+func parseWindow(inputWindow *model.CounterWindow) pbe.Window {
+	if inputWindow == nil {
+		return pbe.Window_ALL_TIME
+	}
+
+	var window pbe.Window
+	switch *inputWindow {
+	case model.CounterWindowLastHour:
+		window = pbe.Window_LAST_HOUR
+	case model.CounterWindowLast24h:
+		window = pbe.Window_LAST_24H
+	case model.CounterWindowLast7d:
+		window = pbe.Window_LAST_7D
+	case model.CounterWindowLast30d:
+		window = pbe.Window_LAST_30D
+	default:
+		window = pbe.Window_ALL_TIME
+	}
+
+	return window
+}
+
+func parseTopInput(input *model.CounterTopInput) *pbe.TopRequest {
+	request := &pbe.TopRequest{}
+	if input == nil {
+		return request
+	}
+
+	if input.Limit != nil {
+		request.Limit = *input.Limit
+	}
+	if input.Offset != nil {
+		request.Offset = *input.Offset
+	}
+	if input.ServiceName != nil {
+		request.ServiceName = *input.ServiceName
+	}
+	if input.SearchType != nil {
+		request.SearchType = *input.SearchType
+	}
+	if input.SessionID != nil {
+		request.SessionId = *input.SessionID
+	}
+
+	return request
+}
+
+func parseZeroResultsInput(input *model.ZeroResultsInput) *pbe.ZeroResultsRequest {
+	request := &pbe.ZeroResultsRequest{}
+	if input == nil {
+		return request
+	}
+
+	if input.Limit != nil {
+		request.Limit = *input.Limit
+	}
+	if input.Offset != nil {
+		request.Offset = *input.Offset
+	}
+	if input.ServiceName != nil {
+		request.ServiceName = *input.ServiceName
+	}
+	// an unspecified language means all languages rather than the Greek default of the search queries
+	if input.Language != nil && *input.Language != model.LanguageLanguageUnspecified {
+		request.Language = parseLanguage(input.Language).String()
+	}
+
+	return request
+}
+
+func parsePage(page *int32) int32 {
+	if page == nil {
+		return defaultPage
+	}
+	return *page
+}
+
+func parseSize(size *int32) int32 {
+	if size == nil {
+		return defaultSize
+	}
+	return *size
+}
+
+func parseCursor(after *string) string {
+	if after == nil {
+		return ""
+	}
+	return *after
+}
+
+// parseConnectionQuery maps the Relay arguments onto a SearchQuery: first is the page size and after the cursor.
+func parseConnectionQuery(word string, language *model.Language, first *int32, after *string) *koinos.SearchQuery {
+	request := &koinos.SearchQuery{
+		Word:        word,
+		Language:    parseLanguage(language),
+		SearchAfter: parseCursor(after),
+	}
+	if first != nil {
+		request.NumberOfResults = *first
+	}
+	return request
+}
@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// persistedQueryManifest is the file the Apollo tooling generates from the client code.
+type persistedQueryManifest struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	Operations []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+// AllowList only executes the operations of a persisted query manifest. Clients send the sha256 of the document in
+// the persistedQuery extension, with or without the document itself; anything else is rejected. It takes the place
+// of automatic persisted queries, which would let a client register any document.
+type AllowList struct {
+	operations map[string]string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = &AllowList{}
+
+// LoadAllowList reads a manifest, every id has to be the sha256 of its body.
+func LoadAllowList(path string) (*AllowList, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read allow list: %w", err)
+	}
+
+	var manifest persistedQueryManifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return nil, fmt.Errorf("parse allow list: %w", err)
+	}
+
+	allowList := &AllowList{operations: make(map[string]string, len(manifest.Operations))}
+	for _, operation := range manifest.Operations {
+		if hash := queryHash(operation.Body); hash != operation.ID {
+			return nil, fmt.Errorf("allow list operation %s has id %s but its body hashes to %s", operation.Name, operation.ID, hash)
+		}
+		allowList.operations[operation.ID] = operation.Body
+	}
+
+	return allowList, nil
+}
+
+func (a *AllowList) Len() int {
+	return len(a.operations)
+}
+
+func (a *AllowList) ExtensionName() string {
+	return "AllowList"
+}
+
+func (a *AllowList) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a *AllowList) MutateOperationParameters(_ context.Context, params *graphql.RawParams) *gqlerror.Error {
+	hash := queryHash(params.Query)
+	if params.Query == "" {
+		persisted, _ := params.Extensions["persistedQuery"].(map[string]interface{})
+		hash, _ = persisted["sha256Hash"].(string)
+	}
+
+	query, ok := a.operations[hash]
+	if !ok {
+		return &gqlerror.Error{
+			Message: "operation is not on the allow list",
+			Extensions: map[string]interface{}{
+				"code":   hamartia.CodeForbidden,
+				"reason": hamartia.ReasonOperationNotAllowed,
+			},
+		}
+	}
+
+	params.Query = query
+	return nil
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
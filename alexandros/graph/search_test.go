@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odysseia-greek/makedonia/alexandros/gateway"
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedSearchCount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	alexandrosHandler := fakeHandler(t, expandFakes{
+		hefaistion: &fakeHefaistion{},
+		antigonos:  &fakeAntigonos{},
+		ptolemaios: &fakePtolemaios{},
+	})
+	alexandrosHandler.SearchEvents = gateway.NewBroker[gateway.SearchEvent]()
+	events := alexandrosHandler.SubscribeSearches(ctx, nil, nil)
+
+	var response struct {
+		Data struct {
+			Search struct{ Results []struct{ Rank int } }
+		}
+	}
+	query(t, schemaServer(alexandrosHandler), `{
+		search(input: {word: "λόγος", strategies: [EXACT, FUZZY]}) { results { rank } }
+		exact(input: {word: "λόγος", expand: false}) { results { headword } }
+	}`, &response)
+	assert.Len(t, response.Data.Search.Results, 2)
+
+	var searches []*model.SearchEvent
+	for {
+		select {
+		case event := <-events:
+			searches = append(searches, event)
+			continue
+		case <-time.After(50 * time.Millisecond):
+		}
+		break
+	}
+
+	// one count for the unified search as a whole, the exact field next to it still counts on its own
+	assert.Len(t, searches, 2)
+	types := map[string]string{}
+	for _, search := range searches {
+		types[search.SearchType] = search.ServiceName
+	}
+	assert.Equal(t, map[string]string{"search": "search", "exact": "exact"}, types)
+}
+
+func TestExplicitNullPaging(t *testing.T) {
+	server := expandServer(t, expandFakes{hefaistion: &fakeHefaistion{}, antigonos: &fakeAntigonos{}, ptolemaios: &fakePtolemaios{}})
+
+	var response struct {
+		Data *struct {
+			Exact struct{ Results []struct{ Headword string } }
+			Fuzzy struct{ Results []struct{ Headword string } }
+		}
+		Errors []struct{ Message string }
+	}
+	// the input defaults do not apply to an explicit null, the resolvers fall back to them
+	query(t, server, `{
+		exact(input: {word: "λόγος", expand: false, page: null, size: null, language: null}) { results { headword } }
+		fuzzy(input: {word: "λόγος", page: null, size: null}) { results { headword } }
+	}`, &response)
+	assert.Empty(t, response.Errors)
+	assert.Len(t, response.Data.Exact.Results, 1)
+	assert.Len(t, response.Data.Fuzzy.Results, 2)
+}
@@ -0,0 +1,22 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+
+	"github.com/odysseia-greek/makedonia/alexandros/graph/model"
+)
+
+// Health is the resolver for the health field.
+func (r *queryResolver) Health(ctx context.Context) (*model.AggregatedHealthResponse, error) {
+	return r.Handler.Health(ctx)
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odysseia-greek/makedonia/alexandros/gateway"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestText(t *testing.T) {
+	type textResponse struct {
+		Data *struct {
+			Text struct {
+				FoundInText *struct{ Rootword string }
+			}
+		}
+		Errors []struct {
+			Message    string
+			Extensions map[string]interface{}
+		}
+	}
+	const document = `{ text(input: {word: "λόγος", expand: false}) { foundInText { rootword } } }`
+
+	t.Run("BackendFails", func(t *testing.T) {
+		fakes := expandFakes{hefaistion: &fakeHefaistion{}, antigonos: &fakeAntigonos{}, ptolemaios: &fakePtolemaios{}}
+		fakes.ptolemaios.err = status.Error(codes.Internal, "the backend is unavailable, try again later")
+
+		var response textResponse
+		query(t, expandServer(t, fakes), document, &response)
+		assert.Nil(t, response.Data)
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, string(hamartia.CodeInternal), response.Errors[0].Extensions["code"])
+	})
+
+	t.Run("NoTextsFoundCountsZero", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		fakes := expandFakes{hefaistion: &fakeHefaistion{}, antigonos: &fakeAntigonos{}, ptolemaios: &fakePtolemaios{}}
+		fakes.ptolemaios.err = status.Error(codes.NotFound, "no texts found for λόγος")
+		alexandrosHandler := fakeHandler(t, fakes)
+		alexandrosHandler.SearchEvents = gateway.NewBroker[gateway.SearchEvent]()
+		events := alexandrosHandler.SubscribeSearches(ctx, nil, nil)
+
+		var response textResponse
+		query(t, schemaServer(alexandrosHandler), document, &response)
+		assert.Empty(t, response.Errors)
+		assert.NotNil(t, response.Data.Text.FoundInText)
+
+		select {
+		case event := <-events:
+			assert.Equal(t, "textSearch", event.SearchType)
+			assert.Equal(t, int32(0), *event.ResultCount)
+		case <-time.After(time.Second):
+			t.Fatal("no search event published")
+		}
+	})
+}
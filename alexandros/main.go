@@ -2,14 +2,20 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/makedonia/alexandros/gateway"
 	"github.com/odysseia-greek/makedonia/alexandros/routing"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 )
 
 const standardPort = ":8080"
@@ -40,11 +46,49 @@ func main() {
 		log.Fatal(err)
 	}
 
-	graphqlServer := routing.InitRoutes(handler)
+	graphqlServer, err := routing.InitRoutes(handler)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	logging.System(fmt.Sprintf("Server running on port %s", port))
-	err = http.ListenAndServe(port, graphqlServer)
+	shutdown, err := hygieia.ShutdownConfigFromEnv()
 	if err != nil {
-		log.Fatal("Server failed to start: ", err)
+		log.Fatal(err)
+	}
+
+	// requests, subscriptions among them, see their context cancelled once the server has shut down
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	server := &http.Server{
+		Addr:        port,
+		Handler:     graphqlServer,
+		BaseContext: func(net.Listener) context.Context { return baseCtx },
+	}
+
+	go func() {
+		logging.System(fmt.Sprintf("Server running on port %s", port))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Server failed to start: ", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	logging.System("shutting down, draining in-flight requests")
+	handler.Drain()
+	time.Sleep(shutdown.DrainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdown.Timeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		logging.Error(fmt.Sprintf("in-flight requests did not finish in time and were cut off: %s", err.Error()))
+		server.Close()
+	}
+	// websockets are hijacked, Shutdown does not wait for them
+	cancelRequests()
+
+	if err := handler.Close(); err != nil {
+		logging.Error(err.Error())
 	}
 }
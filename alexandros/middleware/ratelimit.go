@@ -0,0 +1,346 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/odysseia-greek/agora/plato/config"
+	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	BudgetCheap     string = "cheap"
+	BudgetExpensive string = "expensive"
+)
+
+// RateBudget is a token bucket: Burst requests at once, after which PerSecond requests are let through every second.
+type RateBudget struct {
+	PerSecond float64
+	Burst     int
+}
+
+// RateLimitStore keeps the buckets. The memory store serves a single replica, a shared store lets all replicas
+// draw from the same budget.
+type RateLimitStore interface {
+	// Take removes one token from the bucket under key. When the bucket is empty it reports how long until the next
+	// token is there.
+	Take(ctx context.Context, key string, budget RateBudget) (bool, time.Duration, error)
+}
+
+// RateLimitConfig sets the budgets per caller. Requests containing text, unified search or exact with expand draw
+// from Expensive, everything else from Cheap.
+type RateLimitConfig struct {
+	Cheap     RateBudget
+	Expensive RateBudget
+	Store     RateLimitStore
+	// TrustForwardedFor takes the client IP from X-Forwarded-For, only safe behind a proxy that sets it
+	TrustForwardedFor bool
+}
+
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Cheap:     RateBudget{PerSecond: 10, Burst: 50},
+		Expensive: RateBudget{PerSecond: 1, Burst: 10},
+	}
+}
+
+// rateLimitCall carries the callers from the HTTP request to the operation, and the verdict back.
+type rateLimitCall struct {
+	callers    []string
+	rejected   bool
+	retryAfter time.Duration
+}
+
+type rateLimitKey struct{}
+
+// RateLimiter answers 429 with a Retry-After header once a caller used up its budget. Callers are told apart by their
+// session header and by IP: a request with a session draws from both buckets, so a client cannot get a fresh budget
+// by sending a new session id every time. A failing store lets requests through.
+//
+// The budget is chosen once gqlgen resolved the document, so a persisted query sent as a bare hash is weighed like the
+// document it stands for. Install it as an extension of the GraphQL server and wrap that server in Middleware.
+type RateLimiter struct {
+	cfg   RateLimitConfig
+	store RateLimitStore
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &RateLimiter{}
+
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	store := cfg.Store
+	if store == nil {
+		store = NewMemoryRateStore()
+	}
+	return &RateLimiter{cfg: cfg, store: store}
+}
+
+func (l *RateLimiter) ExtensionName() string {
+	return "RateLimiter"
+}
+
+func (l *RateLimiter) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// Middleware tells the limiter who is calling and turns a rejected operation into a 429.
+func (l *RateLimiter) Middleware() Adapter {
+	return func(f http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			call := &rateLimitCall{callers: callers(r, l.cfg.TrustForwardedFor)}
+			ctx := context.WithValue(r.Context(), rateLimitKey{}, call)
+			f.ServeHTTP(&rateLimitWriter{ResponseWriter: w, call: call}, r.WithContext(ctx))
+		})
+	}
+}
+
+// MutateOperationContext takes a token for every operation, a subscription takes one when it starts.
+func (l *RateLimiter) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	call, ok := ctx.Value(rateLimitKey{}).(*rateLimitCall)
+	if !ok || opCtx.Operation == nil {
+		return nil
+	}
+
+	budgetName, budget := BudgetCheap, l.cfg.Cheap
+	if expensiveOperation(opCtx.Doc, opCtx.Operation, opCtx.Variables) {
+		budgetName, budget = BudgetExpensive, l.cfg.Expensive
+	}
+
+	for _, caller := range call.callers {
+		key := fmt.Sprintf("%s|%s", budgetName, caller)
+		allowed, retryAfter, err := l.store.Take(ctx, key, budget)
+		if err != nil {
+			logging.Error(fmt.Sprintf("rate limit store failed, letting request through: %s", err.Error()))
+			return nil
+		}
+		if !allowed {
+			call.rejected = true
+			call.retryAfter = retryAfter
+			return rateLimited(budgetName, retryAfter)
+		}
+	}
+	return nil
+}
+
+// rateLimitWriter swaps the status gqlgen answers a rejected operation with for a 429.
+type rateLimitWriter struct {
+	http.ResponseWriter
+	call        *rateLimitCall
+	wroteHeader bool
+}
+
+func (w *rateLimitWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if w.call.rejected {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(w.call.retryAfter)))
+			code = http.StatusTooManyRequests
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *rateLimitWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Hijack hands the connection to a WebSocket upgrade.
+func (w *rateLimitWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+// callers are the session id when the client sends one, followed by its IP.
+func callers(r *http.Request, trustForwardedFor bool) []string {
+	ip := "ip:" + clientIP(r, trustForwardedFor)
+	if sessionId := r.Header.Get(config.SessionIdKey); sessionId != "" {
+		return []string{"session:" + sessionId, ip}
+	}
+	return []string{ip}
+}
+
+func clientIP(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return host
+}
+
+// expensiveOperation looks at the root fields of an operation, fragments included, with its variables applied.
+// Requests containing text, unified search or exact with expand are expensive.
+func expensiveOperation(doc *ast.QueryDocument, operation *ast.OperationDefinition, variables map[string]interface{}) bool {
+	return expensiveSelection(doc, operation.SelectionSet, variables)
+}
+
+func expensiveSelection(doc *ast.QueryDocument, selectionSet ast.SelectionSet, variables map[string]interface{}) bool {
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			switch s.Name {
+			case "text", "search":
+				return true
+			case "exact":
+				if expandRequested(s, variables) {
+					return true
+				}
+			}
+		case *ast.FragmentSpread:
+			if fragment := doc.Fragments.ForName(s.Name); fragment != nil && expensiveSelection(doc, fragment.SelectionSet, variables) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if expensiveSelection(doc, s.SelectionSet, variables) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func expandRequested(field *ast.Field, variables map[string]interface{}) bool {
+	argument := field.Arguments.ForName("input")
+	if argument == nil {
+		return false
+	}
+
+	value, err := argument.Value.Value(variables)
+	if err != nil {
+		return false
+	}
+
+	input, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	expand, _ := input["expand"].(bool)
+	return expand
+}
+
+// rateLimited is the error a rejected operation answers with, clients handle it like any other RATE_LIMITED error.
+func rateLimited(budget string, retryAfter time.Duration) *gqlerror.Error {
+	seconds := retryAfterSeconds(retryAfter)
+	return &gqlerror.Error{
+		Message: fmt.Sprintf("rate limit exceeded for %s queries, retry in %d seconds", budget, seconds),
+		Extensions: map[string]interface{}{
+			"code":       hamartia.CodeRateLimited,
+			"budget":     budget,
+			"retryAfter": seconds,
+		},
+	}
+}
+
+// retryAfterSeconds rounds up, a client retrying after zero seconds would be rejected again.
+func retryAfterSeconds(retryAfter time.Duration) int {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+const (
+	// sweepInterval is how often the memory store drops buckets that refilled completely, those are no different from
+	// a bucket that was never used.
+	sweepInterval = time.Minute
+	// maxBuckets makes the memory store sweep every second instead of every minute once it holds this many buckets.
+	maxBuckets = 100000
+)
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+	budget  RateBudget
+}
+
+// refill adds the tokens earned since the last update, up to the burst.
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.budget.Burst), b.tokens+now.Sub(b.updated).Seconds()*b.budget.PerSecond)
+	b.updated = now
+}
+
+// MemoryRateStore keeps the buckets of this replica in memory.
+type MemoryRateStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+	// maxBuckets is reached only by many callers at once, those all pay into their IP bucket as well
+	maxBuckets int
+}
+
+func NewMemoryRateStore() *MemoryRateStore {
+	return &MemoryRateStore{
+		buckets:    make(map[string]*tokenBucket),
+		lastSweep:  time.Now(),
+		now:        time.Now,
+		maxBuckets: maxBuckets,
+	}
+}
+
+func (m *MemoryRateStore) Take(_ context.Context, key string, budget RateBudget) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	sinceSweep := now.Sub(m.lastSweep)
+	if sinceSweep > sweepInterval || (len(m.buckets) >= m.maxBuckets && sinceSweep > time.Second) {
+		m.sweep(now)
+	}
+
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(budget.Burst), updated: now, budget: budget}
+		m.buckets[key] = bucket
+	}
+	bucket.budget = budget
+	bucket.refill(now)
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0, nil
+	}
+
+	if budget.PerSecond <= 0 {
+		return false, sweepInterval, nil
+	}
+	return false, time.Duration((1 - bucket.tokens) / budget.PerSecond * float64(time.Second)), nil
+}
+
+// sweep drops the full buckets. Callers hold m.mu.
+func (m *MemoryRateStore) sweep(now time.Time) {
+	for key, bucket := range m.buckets {
+		bucket.refill(now)
+		if bucket.tokens >= float64(bucket.budget.Burst) {
+			delete(m.buckets, key)
+		}
+	}
+	m.lastSweep = now
+}
@@ -0,0 +1,274 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/odysseia-greek/agora/plato/config"
+	"github.com/odysseia-greek/makedonia/alexandros/gateway"
+	"github.com/odysseia-greek/makedonia/alexandros/graph"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestMemoryRateStore(t *testing.T) {
+	ctx := context.Background()
+	budget := RateBudget{PerSecond: 2, Burst: 3}
+
+	newStore := func() (*MemoryRateStore, *time.Time) {
+		now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		store := NewMemoryRateStore()
+		store.lastSweep = now
+		store.now = func() time.Time { return now }
+		return store, &now
+	}
+
+	t.Run("BurstThenRejected", func(t *testing.T) {
+		store, _ := newStore()
+		for i := 0; i < budget.Burst; i++ {
+			allowed, _, err := store.Take(ctx, "ip:127.0.0.1", budget)
+			assert.Nil(t, err)
+			assert.True(t, allowed)
+		}
+
+		allowed, retryAfter, err := store.Take(ctx, "ip:127.0.0.1", budget)
+		assert.Nil(t, err)
+		assert.False(t, allowed)
+		// an empty bucket earns a token every half second
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+		// other callers have a bucket of their own
+		allowed, _, _ = store.Take(ctx, "ip:127.0.0.2", budget)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Refills", func(t *testing.T) {
+		store, now := newStore()
+		for i := 0; i < budget.Burst; i++ {
+			store.Take(ctx, "session:a", budget)
+		}
+
+		*now = now.Add(250 * time.Millisecond)
+		allowed, retryAfter, _ := store.Take(ctx, "session:a", budget)
+		assert.False(t, allowed)
+		assert.Equal(t, 250*time.Millisecond, retryAfter)
+
+		*now = now.Add(250 * time.Millisecond)
+		allowed, _, _ = store.Take(ctx, "session:a", budget)
+		assert.True(t, allowed)
+
+		// never more than the burst, however long the caller stayed away
+		*now = now.Add(time.Hour)
+		for i := 0; i < budget.Burst; i++ {
+			allowed, _, _ = store.Take(ctx, "session:a", budget)
+			assert.True(t, allowed)
+		}
+		allowed, _, _ = store.Take(ctx, "session:a", budget)
+		assert.False(t, allowed)
+	})
+
+	t.Run("SweepsEarlyAtCapacity", func(t *testing.T) {
+		store, now := newStore()
+		store.maxBuckets = 2
+		store.Take(ctx, "session:a", budget)
+		store.Take(ctx, "session:b", budget)
+
+		// long before the next regular sweep, the full buckets make room
+		*now = now.Add(2 * time.Second)
+		store.Take(ctx, "session:c", budget)
+		assert.Len(t, store.buckets, 1)
+		assert.Contains(t, store.buckets, "session:c")
+	})
+
+	t.Run("SweepsFullBuckets", func(t *testing.T) {
+		store, now := newStore()
+		store.Take(ctx, "session:a", budget)
+
+		*now = now.Add(2 * sweepInterval)
+		store.Take(ctx, "session:b", budget)
+		assert.NotContains(t, store.buckets, "session:a")
+		assert.Contains(t, store.buckets, "session:b")
+	})
+}
+
+func TestExpensiveOperation(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		query         string
+		operationName string
+		variables     map[string]interface{}
+		expensive     bool
+	}{
+		{name: "Fuzzy", query: `{ fuzzy(input: {word: "logos"}) { __typename } }`},
+		{name: "Text", query: `{ text(input: {word: "logos", expand: false}) { __typename } }`, expensive: true},
+		{name: "UnifiedSearch", query: `{ found: search(input: {word: "logos"}) { __typename } }`, expensive: true},
+		{name: "ExactWithoutExpand", query: `{ exact(input: {word: "logos", expand: false}) { __typename } }`},
+		{name: "ExactWithExpand", query: `{ exact(input: {word: "logos", expand: true}) { __typename } }`, expensive: true},
+		{
+			name:      "ExpandFromVariables",
+			query:     `query Exact($input: ExpandableSearchQueryInput!) { exact(input: $input) { __typename } }`,
+			variables: map[string]interface{}{"input": map[string]interface{}{"word": "logos", "expand": true}},
+			expensive: true,
+		},
+		{
+			name:      "ExpandVariableUnset",
+			query:     `query Exact($expand: Boolean!) { exact(input: {word: "logos", expand: $expand}) { __typename } }`,
+			variables: map[string]interface{}{"expand": false},
+		},
+		{name: "FragmentSpread", query: `query { ...Texts } fragment Texts on Query { text(input: {word: "logos", expand: false}) { __typename } }`, expensive: true},
+		{name: "InlineFragment", query: `query { ... on Query { search(input: {word: "logos"}) { __typename } } }`, expensive: true},
+		{
+			name:          "OnlyTheNamedOperation",
+			query:         `query Cheap { fuzzy(input: {word: "logos"}) { __typename } } query Costly { text(input: {word: "logos", expand: false}) { __typename } }`,
+			operationName: "Cheap",
+		},
+		{
+			name:          "TheNamedOperationIsCostly",
+			query:         `query Cheap { fuzzy(input: {word: "logos"}) { __typename } } query Costly { text(input: {word: "logos", expand: false}) { __typename } }`,
+			operationName: "Costly",
+			expensive:     true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parser.ParseQuery(&ast.Source{Input: tc.query})
+			assert.Nil(t, err)
+
+			operation := doc.Operations.ForName(tc.operationName)
+			if tc.operationName == "" {
+				operation = doc.Operations[0]
+			}
+			assert.Equal(t, tc.expensive, expensiveOperation(doc, operation, tc.variables))
+		})
+	}
+}
+
+const (
+	cheapQuery     = `{ __typename }`
+	expensiveQuery = `query Text { text(input: {word: "logos", expand: false}) { __typename } }`
+)
+
+// rateLimitedServer only lets the cheap query through, the expensive budget is empty from the start so nothing ever
+// reaches a backend.
+func rateLimitedServer() http.Handler {
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers: &graph.Resolver{Handler: &gateway.AlexandrosHandler{}},
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](10)})
+
+	limiter := NewRateLimiter(RateLimitConfig{
+		Cheap:     RateBudget{PerSecond: 1, Burst: 1},
+		Expensive: RateBudget{PerSecond: 0.5, Burst: 0},
+	})
+	srv.Use(limiter)
+	return Adapt(srv, limiter.Middleware(), LimitBody(1<<10))
+}
+
+func post(t *testing.T, server http.Handler, body map[string]interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	return postAs(t, server, "d2f1c0de", body)
+}
+
+func postAs(t *testing.T, server http.Handler, session string, body map[string]interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	raw, err := json.Marshal(body)
+	assert.Nil(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, "/alexandros/graphql", bytes.NewReader(raw))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(config.SessionIdKey, session)
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	return recorder
+}
+
+type graphqlResponse struct {
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func decodeErrors(t *testing.T, recorder *httptest.ResponseRecorder) graphqlResponse {
+	t.Helper()
+	var response graphqlResponse
+	assert.Nil(t, json.NewDecoder(recorder.Body).Decode(&response))
+	return response
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("AnswersTooManyRequests", func(t *testing.T) {
+		server := rateLimitedServer()
+
+		recorder := post(t, server, map[string]interface{}{"query": expensiveQuery})
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Equal(t, "2", recorder.Header().Get("Retry-After"))
+
+		response := decodeErrors(t, recorder)
+		assert.Len(t, response.Errors, 1)
+		assert.Equal(t, "rate limit exceeded for expensive queries, retry in 2 seconds", response.Errors[0].Message)
+		assert.Equal(t, string(hamartia.CodeRateLimited), response.Errors[0].Extensions["code"])
+		assert.Equal(t, BudgetExpensive, response.Errors[0].Extensions["budget"])
+		assert.Equal(t, float64(2), response.Errors[0].Extensions["retryAfter"])
+	})
+
+	t.Run("BudgetsAreSeparate", func(t *testing.T) {
+		server := rateLimitedServer()
+		post(t, server, map[string]interface{}{"query": expensiveQuery})
+
+		recorder := post(t, server, map[string]interface{}{"query": cheapQuery})
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Empty(t, recorder.Header().Get("Retry-After"))
+
+		recorder = post(t, server, map[string]interface{}{"query": cheapQuery})
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Equal(t, BudgetCheap, decodeErrors(t, recorder).Errors[0].Extensions["budget"])
+	})
+
+	t.Run("PersistedHashWeighsLikeItsDocument", func(t *testing.T) {
+		server := rateLimitedServer()
+		sum := sha256.Sum256([]byte(expensiveQuery))
+		extensions := map[string]interface{}{
+			"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": hex.EncodeToString(sum[:])},
+		}
+
+		// registers the document, and is rejected for it
+		recorder := post(t, server, map[string]interface{}{"query": expensiveQuery, "extensions": extensions})
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+
+		recorder = post(t, server, map[string]interface{}{"extensions": extensions})
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		assert.Equal(t, BudgetExpensive, decodeErrors(t, recorder).Errors[0].Extensions["budget"])
+	})
+
+	t.Run("RotatingSessionsShareTheIPBudget", func(t *testing.T) {
+		server := rateLimitedServer()
+
+		recorder := postAs(t, server, "a11ce", map[string]interface{}{"query": cheapQuery})
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		// a new session id is no new budget, the request still comes from the same IP
+		recorder = postAs(t, server, "b0b", map[string]interface{}{"query": cheapQuery})
+		assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	})
+
+	t.Run("RejectsOversizedBodies", func(t *testing.T) {
+		server := rateLimitedServer()
+		recorder := post(t, server, map[string]interface{}{"query": cheapQuery, "variables": map[string]interface{}{"padding": string(make([]byte, 2<<10))}})
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+}
@@ -0,0 +1,173 @@
+package routing
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/odysseia-greek/makedonia/alexandros/gateway"
+	pbe "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+)
+
+var csvHeader = []string{"scope", "session_id", "service_name", "word", "count", "last_used", "hits", "zero_results", "search_types", "languages"}
+
+// exportCounters streams the Eukleides counters as a CSV (default) or NDJSON download, e.g.
+// /alexandros/v1/export?format=ndjson&scope=session&service=exact&since=2025-01-01T00:00:00Z
+func exportCounters(handlerConfig *gateway.AlexandrosHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		format := query.Get("format")
+		if format == "" {
+			format = formatCSV
+		}
+		if format != formatCSV && format != formatNDJSON {
+			http.Error(w, fmt.Sprintf("unsupported format %q, use csv or ndjson", format), http.StatusBadRequest)
+			return
+		}
+
+		scope, err := parseExportScope(query.Get("scope"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		stream, err := handlerConfig.ExportCounters(r.Context(), &pbe.ExportRequest{
+			Scope:       scope,
+			ServiceName: query.Get("service"),
+			Since:       query.Get("since"),
+		})
+		if err != nil {
+			writeExportError(w, err)
+			return
+		}
+
+		// the first message tells us whether eukleides accepted the request before anything is written
+		first, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			writeExportError(w, err)
+			return
+		}
+
+		filename := fmt.Sprintf("eukleides-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+		var write func(*pbe.ExportedCounter) error
+		var flush func() error
+		switch format {
+		case formatNDJSON:
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			marshaller := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+			write = func(counter *pbe.ExportedCounter) error {
+				line, err := marshaller.Marshal(counter)
+				if err != nil {
+					return err
+				}
+				_, err = w.Write(append(line, '\n'))
+				return err
+			}
+			flush = func() error { return nil }
+		default:
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			writer := csv.NewWriter(w)
+			if err := writer.Write(csvHeader); err != nil {
+				return
+			}
+			write = func(counter *pbe.ExportedCounter) error {
+				return writer.Write(csvRecord(counter))
+			}
+			flush = func() error {
+				writer.Flush()
+				return writer.Error()
+			}
+		}
+
+		exported := 0
+		for counter := first; counter != nil; {
+			if err := write(counter); err != nil {
+				logging.Error(fmt.Sprintf("export aborted after %d counters: %s", exported, err.Error()))
+				return
+			}
+			exported++
+
+			counter, err = stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					// headers are out already, so all we can do is cut the download short
+					logging.Error(fmt.Sprintf("export aborted after %d counters: %s", exported, err.Error()))
+				}
+				break
+			}
+		}
+
+		if err := flush(); err != nil {
+			logging.Error(fmt.Sprintf("failed to flush export: %s", err.Error()))
+		}
+	}
+}
+
+func parseExportScope(scope string) (pbe.ExportScope, error) {
+	switch scope {
+	case "", "all":
+		return pbe.ExportScope_EXPORT_ALL, nil
+	case "global":
+		return pbe.ExportScope_EXPORT_GLOBAL, nil
+	case "session":
+		return pbe.ExportScope_EXPORT_SESSION, nil
+	default:
+		return pbe.ExportScope_EXPORT_ALL, fmt.Errorf("unsupported scope %q, use all, global or session", scope)
+	}
+}
+
+func csvRecord(counter *pbe.ExportedCounter) []string {
+	return []string{
+		counter.Scope,
+		counter.SessionId,
+		counter.ServiceName,
+		counter.Word,
+		strconv.FormatInt(counter.Count, 10),
+		counter.LastUsed,
+		strconv.FormatInt(counter.Hits, 10),
+		strconv.FormatInt(counter.ZeroResults, 10),
+		joinCounts(counter.SearchTypes),
+		joinCounts(counter.Languages),
+	}
+}
+
+// joinCounts flattens a map into "key=count" pairs separated by ";" with sorted keys, e.g. "exact=3;fuzzy=1".
+func joinCounts(counts map[string]int64) string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", k, counts[k]))
+	}
+	return strings.Join(pairs, ";")
+}
+
+func writeExportError(w http.ResponseWriter, err error) {
+	code := http.StatusBadGateway
+	if status.Code(err) == codes.InvalidArgument {
+		code = http.StatusBadRequest
+	}
+	http.Error(w, err.Error(), code)
+}
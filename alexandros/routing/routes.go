@@ -1,50 +1,238 @@
 package routing
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/odysseia-greek/agora/plato/config"
+	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/agora/plato/models"
 	"github.com/odysseia-greek/makedonia/alexandros/gateway"
 	"github.com/odysseia-greek/makedonia/alexandros/graph"
 	"github.com/odysseia-greek/makedonia/alexandros/middleware"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	defaultQueryCacheSize = 1000
+	// defaultMaxBodyBytes fits any search document with room to spare
+	defaultMaxBodyBytes = 1 << 20
 )
 
 // InitRoutes initializes the mux router with middleware and GraphQL handler
-func InitRoutes(handlerConfig *gateway.AlexandrosHandler) *mux.Router {
+func InitRoutes(handlerConfig *gateway.AlexandrosHandler) (*mux.Router, error) {
 	serveMux := mux.NewRouter()
 
+	limits := limitsFromEnv()
 	srv := handler.New(graph.NewExecutableSchema(
-		graph.Config{Resolvers: &graph.Resolver{Handler: handlerConfig}},
+		graph.Config{
+			Resolvers:  &graph.Resolver{Handler: handlerConfig},
+			Complexity: limits.Complexity(),
+		},
 	))
 
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+	// every operation gets its own loader, so identical backend calls within it are made once
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(gateway.WithLoader(ctx))
+	})
+
+	// the live feeds show what is searched right now, so the WebSocket transport is only offered when explicitly enabled
+	if config.BoolFromEnv("ENABLE_SUBSCRIPTIONS") {
+		srv.AddTransport(transport.Websocket{
+			KeepAlivePingInterval: 10 * time.Second,
+			Upgrader: websocket.Upgrader{
+				CheckOrigin: checkOrigin(config.StringFromEnv("SUBSCRIPTION_ALLOWED_ORIGINS", "")),
+			},
+		})
+	}
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
 	srv.Use(extension.Introspection{})
+	if err := usePersistedQueries(srv); err != nil {
+		return nil, err
+	}
+	srv.Use(&graph.QueryLimits{Limits: limits})
 
-	graphqlHandler := middleware.Adapt(
-		srv,
-		middleware.LogRequestDetails(handlerConfig.Streamer),
-	)
+	adapters := []middleware.Adapter{middleware.LogRequestDetails(handlerConfig.Streamer)}
+	// the limiter weighs the resolved document, a persisted query sent as a hash costs what its document costs. A
+	// rejected request is parsed and validated, never executed.
+	if config.BoolFromEnv("ENABLE_RATE_LIMIT") {
+		limiter := middleware.NewRateLimiter(rateLimitFromEnv())
+		srv.Use(limiter)
+		adapters = append(adapters, limiter.Middleware())
+	}
+	maxBodyBytes, err := strconv.ParseInt(config.StringFromEnv("REQUEST_MAX_BYTES", strconv.Itoa(defaultMaxBodyBytes)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid REQUEST_MAX_BYTES: %w", err)
+	}
+	adapters = append(adapters, middleware.LimitBody(maxBodyBytes))
+	graphqlHandler := middleware.Adapt(srv, adapters...)
 
 	serveMux.Handle("/alexandros/graphql", graphqlHandler)
 
+	// raw counters include session ids, so the export is only served when explicitly enabled
+	if config.BoolFromEnv("ENABLE_EXPORT") {
+		serveMux.HandleFunc("/alexandros/v1/export", exportCounters(handlerConfig)).Methods(http.MethodGet)
+	}
+
 	// --- health endpoints ---
+	// liveness only tells this process serves requests, a failing backend is no reason to restart it
 	serveMux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		writeHealthResponse(w)
 	})
+	serveMux.HandleFunc("/readyz", readiness(handlerConfig))
 	serveMux.HandleFunc("/alexandros/v1/ping", func(w http.ResponseWriter, r *http.Request) {
 		writeHealthResponse(w)
 	})
 
-	return serveMux
+	return serveMux, nil
+}
+
+// usePersistedQueries turns on automatic persisted queries, or strict mode when OPERATION_ALLOW_LIST points at a
+// persisted query manifest. Parsed documents are cached either way, clients send the same few over and over.
+func usePersistedQueries(srv *handler.Server) error {
+	cacheSize, err := strconv.Atoi(config.StringFromEnv("APQ_CACHE_SIZE", strconv.Itoa(defaultQueryCacheSize)))
+	if err != nil {
+		return fmt.Errorf("invalid APQ_CACHE_SIZE: %w", err)
+	}
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](cacheSize))
+
+	allowListPath := config.StringFromEnv("OPERATION_ALLOW_LIST", "")
+	if allowListPath == "" {
+		srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](cacheSize)})
+		logging.System(fmt.Sprintf("automatic persisted queries enabled, caching %d queries", cacheSize))
+		return nil
+	}
+
+	allowList, err := graph.LoadAllowList(allowListPath)
+	if err != nil {
+		return err
+	}
+	srv.Use(allowList)
+	logging.System(fmt.Sprintf("strict mode: only the %d operations in %s are executed", allowList.Len(), allowListPath))
+	return nil
+}
+
+// checkOrigin only lets browsers on the comma separated origins open a subscription. Without a list the upgrader
+// falls back to same origin only. Clients that send no Origin, which browsers always do, are let through.
+func checkOrigin(allowed string) func(r *http.Request) bool {
+	if allowed == "" {
+		return nil
+	}
+
+	origins := make(map[string]struct{})
+	for _, origin := range strings.Split(allowed, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins[origin] = struct{}{}
+		}
+	}
+	logging.System(fmt.Sprintf("subscriptions accepted from %d origins", len(origins)))
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		_, ok := origins[origin]
+		return ok
+	}
+}
+
+// limitsFromEnv overrides the default query limits, a value that is not a number keeps its default.
+func limitsFromEnv() graph.Limits {
+	limits := graph.DefaultLimits()
+
+	for env, limit := range map[string]*int{
+		"QUERY_MAX_COMPLEXITY": &limits.MaxComplexity,
+		"QUERY_MAX_DEPTH":      &limits.MaxDepth,
+		"QUERY_MAX_ALIASES":    &limits.MaxAliases,
+		"QUERY_SEARCH_COST":    &limits.SearchCost,
+		"QUERY_EXPAND_COST":    &limits.ExpandCost,
+		"QUERY_TEXT_COST":      &limits.TextCost,
+	} {
+		parsed, err := strconv.Atoi(config.StringFromEnv(env, strconv.Itoa(*limit)))
+		if err != nil {
+			logging.Error(fmt.Sprintf("invalid %s, keeping %d: %s", env, *limit, err.Error()))
+			continue
+		}
+		*limit = parsed
+	}
+
+	logging.System(fmt.Sprintf("query limits: complexity %d, depth %d, aliases %d", limits.MaxComplexity, limits.MaxDepth, limits.MaxAliases))
+	return limits
+}
+
+// rateLimitFromEnv overrides the default budgets, a value that is not a number keeps its default.
+func rateLimitFromEnv() middleware.RateLimitConfig {
+	rateLimit := middleware.DefaultRateLimitConfig()
+
+	for env, rate := range map[string]*float64{
+		"RATE_LIMIT_CHEAP_PER_SECOND":     &rateLimit.Cheap.PerSecond,
+		"RATE_LIMIT_EXPENSIVE_PER_SECOND": &rateLimit.Expensive.PerSecond,
+	} {
+		parsed, err := strconv.ParseFloat(config.StringFromEnv(env, strconv.FormatFloat(*rate, 'f', -1, 64)), 64)
+		if err != nil {
+			logging.Error(fmt.Sprintf("invalid %s, keeping %g: %s", env, *rate, err.Error()))
+			continue
+		}
+		*rate = parsed
+	}
+
+	for env, burst := range map[string]*int{
+		"RATE_LIMIT_CHEAP_BURST":     &rateLimit.Cheap.Burst,
+		"RATE_LIMIT_EXPENSIVE_BURST": &rateLimit.Expensive.Burst,
+	} {
+		parsed, err := strconv.Atoi(config.StringFromEnv(env, strconv.Itoa(*burst)))
+		if err != nil {
+			logging.Error(fmt.Sprintf("invalid %s, keeping %d: %s", env, *burst, err.Error()))
+			continue
+		}
+		*burst = parsed
+	}
+
+	rateLimit.TrustForwardedFor = config.BoolFromEnv("RATE_LIMIT_TRUST_FORWARDED_FOR")
+
+	logging.System(fmt.Sprintf("rate limit: cheap %g/s (burst %d), expensive %g/s (burst %d)",
+		rateLimit.Cheap.PerSecond, rateLimit.Cheap.Burst, rateLimit.Expensive.PerSecond, rateLimit.Expensive.Burst))
+	return rateLimit
+}
+
+// readiness answers 503 while a critical backend is down or a shutdown is draining requests, so traffic goes to
+// replicas that can serve it. A degraded alexandros stays ready.
+func readiness(handlerConfig *gateway.AlexandrosHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if handlerConfig.Draining() {
+			http.Error(w, "shutting down", http.StatusServiceUnavailable)
+			return
+		}
+
+		health, err := handlerConfig.Health(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !health.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(health)
+	}
 }
 
 // writeHealthResponse is the lightweight ping response
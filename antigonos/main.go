@@ -6,14 +6,16 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
 	v1 "github.com/odysseia-greek/makedonia/antigonos/gen/go/v1"
 	"github.com/odysseia-greek/makedonia/antigonos/monophthalmus"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 )
 
 const standardPort = ":50060"
@@ -47,6 +49,11 @@ func main() {
 		log.Fatal("death has found me")
 	}
 
+	shutdown, err := hygieia.ShutdownConfigFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -65,12 +72,27 @@ func main() {
 		),
 	)
 
-	reflection.Register(server)
-
 	v1.RegisterAntigonosServiceServer(server, cfg)
+	health := hygieia.Register(ctx, server, v1.AntigonosService_ServiceDesc.ServiceName, cfg.Healthy, hygieia.Config{
+		Reflection: config.BoolFromEnv("ENABLE_REFLECTION"),
+	})
 
-	logging.Info(fmt.Sprintf("Server listening on %s", port))
-	if err := server.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	go func() {
+		logging.Info(fmt.Sprintf("Server listening on %s", port))
+		if err := server.Serve(listener); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	logging.System("shutting down, draining in-flight requests")
+	if !hygieia.GracefulStop(server, health, shutdown) {
+		logging.Error("in-flight requests did not finish in time and were cut off")
+	}
+	if err := cfg.Close(); err != nil {
+		logging.Error(err.Error())
 	}
 }
@@ -2,7 +2,7 @@ package monophthalmus
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +11,7 @@ import (
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
 	v1 "github.com/odysseia-greek/makedonia/antigonos/gen/go/v1"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
 	"github.com/odysseia-greek/makedonia/filippos/hetairoi"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -32,11 +33,22 @@ func (f *FuzzyServiceImpl) Health(ctx context.Context, request *emptypb.Empty) (
 	}, nil
 }
 
+// Healthy drives the grpc.health.v1 status, the service is of no use without Elasticsearch.
+func (f *FuzzyServiceImpl) Healthy(ctx context.Context) bool {
+	return f.Elastic.Health().Info().Healthy
+}
+
+// Close ends the trace stream and closes the cache.
+func (f *FuzzyServiceImpl) Close() error {
+	return errors.Join(f.Streamer.CloseSend(), f.Archytas.Close())
+}
+
 func (f *FuzzyServiceImpl) Search(ctx context.Context, request *koinos.SearchQuery) (*v1.SearchResponse, error) {
 	baseWord := extractBaseWord(request.Word)
 
-	if request.NumberOfResults == 0 {
-		request.NumberOfResults = 5
+	page, err := hetairoi.PageFromQuery(request)
+	if err != nil {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidPage, err)
 	}
 
 	var query map[string]interface{}
@@ -65,7 +77,6 @@ func (f *FuzzyServiceImpl) Search(ctx context.Context, request *koinos.SearchQue
 					"minimum_should_match": 1,
 				},
 			},
-			"size": request.NumberOfResults,
 		}
 	} else {
 		var lang string
@@ -75,7 +86,7 @@ func (f *FuzzyServiceImpl) Search(ctx context.Context, request *koinos.SearchQue
 		case koinos.Language_LANG_DUTCH:
 			lang = "dutch"
 		default:
-			return nil, fmt.Errorf("unsupported language: %v", request.Language)
+			return nil, hamartia.UnsupportedLanguage(request.Language)
 		}
 
 		query = map[string]interface{}{
@@ -87,40 +98,30 @@ func (f *FuzzyServiceImpl) Search(ctx context.Context, request *koinos.SearchQue
 					},
 				},
 			},
-			"size": request.NumberOfResults,
 		}
 	}
 
-	elasticResponse, err := f.Elastic.Query().Match(f.Index, query)
+	page.Apply(query)
+
+	raw, err := f.Elastic.Query().MatchRaw(f.Index, query)
 	if err != nil {
-		return nil, fmt.Errorf("error querying elastic: %w", err)
+		return nil, hamartia.Backend(ctx, hamartia.ReasonSearchBackend, fmt.Errorf("error querying elastic: %w", err))
 	}
 
-	hitsTotal := int64(0)
-	if elasticResponse.Hits.Hits != nil {
-		hitsTotal = elasticResponse.Hits.Total.Value
+	elasticResponse, err := hetairoi.DecodeSearchResult(raw)
+	if err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
-	go comedy.DatabaseSpan(query, hitsTotal, elasticResponse.Took, ctx, f.Streamer)
-
-	hits := elasticResponse.Hits.Hits
-
-	results := make([]*koinos.Lemma, 0, len(hits))
-	for _, hit := range hits {
-		source, _ := json.Marshal(hit.Source)
-		var src hetairoi.LemmaSource
-		if err := json.Unmarshal(source, &src); err != nil {
-			b, _ := json.Marshal(hit.Source)
-			if err2 := json.Unmarshal(b, &src); err2 != nil {
-				return nil, fmt.Errorf("decode _source: %w", err2)
-			}
-		}
+	go comedy.DatabaseSpan(query, elasticResponse.Hits.Total.Value, elasticResponse.Took, ctx, f.Streamer)
 
-		results = append(results, hetairoi.LemmaFromSource(src))
+	results, err := page.Lemmas(elasticResponse)
+	if err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
 
 	resp := &v1.SearchResponse{
 		Results:  results,
-		PageInfo: &koinos.PageInfo{Page: 1, Size: int32(len(results)), Total: int32(hitsTotal)},
+		PageInfo: page.Info(elasticResponse),
 	}
 	return resp, nil
 }
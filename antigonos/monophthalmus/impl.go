@@ -3,7 +3,6 @@ package monophthalmus
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/odysseia-greek/agora/archytas"
 	"github.com/odysseia-greek/agora/aristoteles"
@@ -11,8 +10,10 @@ import (
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	v1 "github.com/odysseia-greek/makedonia/antigonos/gen/go/v1"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -39,7 +40,8 @@ type FuzzyServiceClient struct {
 	Impl FuzzyService
 }
 type FuzzyClient struct {
-	fuzzy v1.AntigonosServiceClient
+	fuzzy  v1.AntigonosServiceClient
+	health grpc_health_v1.HealthClient
 }
 
 func NewAntigonosClient(address string) (*FuzzyClient, error) {
@@ -51,24 +53,11 @@ func NewAntigonosClient(address string) (*FuzzyClient, error) {
 		return nil, fmt.Errorf("failed to connect to tracing service: %w", err)
 	}
 	client := v1.NewAntigonosServiceClient(conn)
-	return &FuzzyClient{fuzzy: client}, nil
+	return &FuzzyClient{fuzzy: client, health: grpc_health_v1.NewHealthClient(conn)}, nil
 }
 
 func (f *FuzzyClient) WaitForHealthyState() bool {
-	timeout := 30 * time.Second
-	checkInterval := 1 * time.Second
-	endTime := time.Now().Add(timeout)
-
-	for time.Now().Before(endTime) {
-		response, err := f.Health(context.Background(), &emptypb.Empty{})
-		if err == nil && response.Healthy {
-			return true
-		}
-
-		time.Sleep(checkInterval)
-	}
-
-	return false
+	return hygieia.WaitForServing(f.health, v1.AntigonosService_ServiceDesc.ServiceName, hygieia.DefaultWaitTimeout)
 }
 
 func (f *FuzzyClient) Health(ctx context.Context, request *emptypb.Empty) (*koinos.HealthResponse, error) {
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+type lemmaConnection struct {
+	Edges []struct {
+		Cursor string `json:"cursor"`
+		Node   struct {
+			Headword string `json:"headword"`
+		} `json:"node"`
+	} `json:"edges"`
+	PageInfo struct {
+		HasNextPage     bool    `json:"hasNextPage"`
+		HasPreviousPage bool    `json:"hasPreviousPage"`
+		StartCursor     *string `json:"startCursor"`
+		EndCursor       *string `json:"endCursor"`
+	} `json:"pageInfo"`
+	TotalCount int `json:"totalCount"`
+}
+
+var _ = Describe("search connections", func() {
+	for _, field := range []string{"exactConnection", "fuzzyConnection", "phraseConnection", "partialConnection"} {
+		field := field
+
+		It(fmt.Sprintf("pages %s forward with edge cursors", field), func(ctx context.Context) {
+			c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			q := fmt.Sprintf(`query($word: String!, $first: Int, $after: String) { %s(word: $word, first: $first, after: $after) {
+		edges {
+			cursor
+			node {
+				headword
+			}
+		}
+		pageInfo {
+			hasNextPage
+			hasPreviousPage
+			startCursor
+			endCursor
+		}
+		totalCount
+	}
+}`, field)
+
+			fetch := func(vars map[string]any) lemmaConnection {
+				var resp map[string]lemmaConnection
+				err := gq.Execute(c, baseURL, q, vars, &resp)
+				Expect(err).NotTo(HaveOccurred())
+				return resp[field]
+			}
+
+			first := fetch(map[string]any{"word": "λόγος", "first": 2})
+			Expect(len(first.Edges)).To(BeNumerically("<=", 2))
+			Expect(first.PageInfo.HasPreviousPage).To(BeFalse())
+			Expect(first.TotalCount).To(BeNumerically(">=", len(first.Edges)))
+			for _, edge := range first.Edges {
+				Expect(edge.Cursor).NotTo(BeEmpty())
+				Expect(edge.Node.Headword).NotTo(BeEmpty())
+			}
+			if !first.PageInfo.HasNextPage {
+				return
+			}
+			Expect(*first.PageInfo.EndCursor).To(Equal(first.Edges[len(first.Edges)-1].Cursor))
+
+			next := fetch(map[string]any{"word": "λόγος", "first": 2, "after": *first.PageInfo.EndCursor})
+			Expect(next.Edges).NotTo(BeEmpty())
+			Expect(next.PageInfo.HasPreviousPage).To(BeTrue())
+			Expect(next.Edges[0].Cursor).NotTo(Equal(first.Edges[len(first.Edges)-1].Cursor))
+		}, SpecTimeout(20*time.Second))
+	}
+})
@@ -39,4 +39,22 @@ var _ = Describe("counterTopFive query", func() {
 			Expect(e.Count).To(BeNumerically(">=", 0))
 		}
 	}, SpecTimeout(15*time.Second))
+
+	It("returns top five counters within the last 24 hours", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		const q = `query { counterTopFive(window: LAST_24H) { topFive { lastUsed serviceName word count } } }`
+		var resp counterTopFiveResponse
+		err := gq.Execute(c, baseURL, q, nil, &resp)
+		Expect(err).NotTo(HaveOccurred())
+
+		tf := resp.CounterTopFive.TopFive
+		Expect(tf).NotTo(BeNil())
+		Expect(len(tf)).To(BeNumerically("<=", 5))
+		for _, e := range tf {
+			Expect(e.Word).NotTo(BeEmpty())
+			Expect(e.Count).To(BeNumerically(">", 0))
+		}
+	}, SpecTimeout(15*time.Second))
 })
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+type counterTopResponse struct {
+	CounterTop struct {
+		Entries []struct {
+			LastUsed    string `json:"lastUsed"`
+			ServiceName string `json:"serviceName"`
+			Word        string `json:"word"`
+			Count       int    `json:"count"`
+		} `json:"entries"`
+		Total  int `json:"total"`
+		Limit  int `json:"limit"`
+		Offset int `json:"offset"`
+	} `json:"counterTop"`
+}
+
+var _ = Describe("counterTop query", func() {
+	const q = `query CounterTop($input: CounterTopInput) {
+  counterTop(input: $input) { entries { lastUsed serviceName word count } total limit offset }
+}`
+
+	It("returns a page no larger than the limit", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		vars := map[string]any{"input": map[string]any{"limit": 3, "offset": 0}}
+		var resp counterTopResponse
+		err := gq.Execute(c, baseURL, q, vars, &resp)
+		Expect(err).NotTo(HaveOccurred())
+
+		page := resp.CounterTop
+		Expect(page.Limit).To(Equal(3))
+		Expect(page.Offset).To(Equal(0))
+		Expect(len(page.Entries)).To(BeNumerically("<=", 3))
+		Expect(page.Total).To(BeNumerically(">=", len(page.Entries)))
+		for i := 1; i < len(page.Entries); i++ {
+			Expect(page.Entries[i-1].Count).To(BeNumerically(">=", page.Entries[i].Count))
+		}
+	}, SpecTimeout(15*time.Second))
+
+	It("only returns entries for the requested service", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		vars := map[string]any{"input": map[string]any{"serviceName": "exact", "limit": 10}}
+		var resp counterTopResponse
+		err := gq.Execute(c, baseURL, q, vars, &resp)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, e := range resp.CounterTop.Entries {
+			Expect(e.ServiceName).To(Equal("exact"))
+			Expect(e.Count).To(BeNumerically(">", 0))
+		}
+	}, SpecTimeout(15*time.Second))
+
+	It("returns an empty page past the last entry", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		vars := map[string]any{"input": map[string]any{"limit": 5, "offset": 100000}}
+		var resp counterTopResponse
+		err := gq.Execute(c, baseURL, q, vars, &resp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.CounterTop.Entries).To(BeEmpty())
+	}, SpecTimeout(15*time.Second))
+})
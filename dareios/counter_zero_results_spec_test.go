@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+type counterZeroResultsResponse struct {
+	CounterZeroResults struct {
+		Entries []struct {
+			ServiceName string `json:"serviceName"`
+			Word        string `json:"word"`
+			Count       int    `json:"count"`
+		} `json:"entries"`
+		Total int `json:"total"`
+	} `json:"counterZeroResults"`
+}
+
+var _ = Describe("counterZeroResults query", func() {
+	const q = `query CounterZeroResults($input: ZeroResultsInput) {
+  counterZeroResults(input: $input) { entries { serviceName word count } total }
+}`
+
+	It("lists a word that was searched without any result", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		// a headword that cannot exist in the dictionary
+		const missing = "ζζζζζζζ"
+		const exact = `query Exact($input: ExpandableSearchQueryInput!) { exact(input: $input) { pageInfo { total } } }`
+		exactVars := map[string]any{"input": map[string]any{"word": missing, "language": "LANG_GREEK", "expand": false}}
+		var exactResp map[string]any
+		Expect(gq.Execute(c, baseURL, exact, exactVars, &exactResp)).To(Succeed())
+
+		// counts are pushed asynchronously
+		Eventually(func(g Gomega) {
+			vars := map[string]any{"input": map[string]any{"serviceName": "exact", "language": "LANG_GREEK", "limit": 100}}
+			var resp counterZeroResultsResponse
+			g.Expect(gq.Execute(c, baseURL, q, vars, &resp)).To(Succeed())
+
+			words := make([]string, 0, len(resp.CounterZeroResults.Entries))
+			for _, e := range resp.CounterZeroResults.Entries {
+				g.Expect(e.ServiceName).To(Equal("exact"))
+				g.Expect(e.Count).To(BeNumerically(">", 0))
+				words = append(words, e.Word)
+			}
+			g.Expect(words).To(ContainElement(missing))
+		}).WithContext(c).WithTimeout(5 * time.Second).WithPolling(500 * time.Millisecond).Should(Succeed())
+	}, SpecTimeout(15*time.Second))
+})
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+var _ = Describe("error extensions", func() {
+	It("marks a negative page size as bad user input", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		const q = `query($input: SearchQueryInput!) { partial(input: $input) {
+		results {
+			headword
+		}
+	}
+}`
+		vars := map[string]any{
+			"input": map[string]any{
+				"word": "λόγο",
+				"size": -1,
+			},
+		}
+		err := gq.Execute(c, baseURL, q, vars, nil)
+
+		var gqlErr *gq.Error
+		Expect(errors.As(err, &gqlErr)).To(BeTrue())
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("code", "BAD_USER_INPUT"))
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("reason", "INVALID_PAGE"))
+	}, SpecTimeout(20*time.Second))
+
+	It("marks a malformed cursor as bad user input", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		const q = `query($word: String!, $after: String) { fuzzyConnection(word: $word, after: $after) {
+		totalCount
+	}
+}`
+		err := gq.Execute(c, baseURL, q, map[string]any{"word": "λόγος", "after": "not a cursor"}, nil)
+
+		var gqlErr *gq.Error
+		Expect(errors.As(err, &gqlErr)).To(BeTrue())
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("code", "BAD_USER_INPUT"))
+	}, SpecTimeout(20*time.Second))
+})
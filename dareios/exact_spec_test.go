@@ -223,4 +223,36 @@ var _ = Describe("exact query", func() {
 		Expect(len(f.Results[0].Verb.PrincipalParts)).To(BeNumerically(">=", 1))
 
 	}, SpecTimeout(20*time.Second))
+
+	It("answers aliases asking for the same word with the same results", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		const aliased = `query($input: ExpandableSearchQueryInput!) {
+		first: exact(input: $input) { results { headword } pageInfo { total } }
+		second: exact(input: $input) { results { headword } pageInfo { total } }
+	}`
+		vars := map[string]any{
+			"input": map[string]any{
+				"word":   "λόγος",
+				"expand": false,
+			},
+		}
+
+		type aliasResult struct {
+			Results []struct {
+				Headword string `json:"headword"`
+			} `json:"results"`
+			PageInfo struct {
+				Total int `json:"total"`
+			} `json:"pageInfo"`
+		}
+		var resp struct {
+			First  aliasResult `json:"first"`
+			Second aliasResult `json:"second"`
+		}
+		err := gq.Execute(c, baseURL, aliased, vars, &resp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Second).To(Equal(resp.First))
+	}, SpecTimeout(20*time.Second))
 })
@@ -14,11 +14,15 @@ type healthResponse struct {
 	Health struct {
 		Time     string `json:"time"`
 		Healthy  bool   `json:"healthy"`
+		Degraded bool   `json:"degraded"`
 		Version  string `json:"version"`
 		Services []struct {
 			Name         string `json:"name"`
 			Version      string `json:"version"`
 			Healthy      bool   `json:"healthy"`
+			Critical     bool   `json:"critical"`
+			LatencyMs    int    `json:"latencyMs"`
+			Error        string `json:"error"`
 			DatabaseInfo struct {
 				Healthy       bool   `json:"healthy"`
 				ServerName    string `json:"serverName"`
@@ -34,7 +38,7 @@ var _ = Describe("health query", func() {
 		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		const q = `query { health { time healthy version services { name version healthy databaseInfo { healthy serverName serverVersion clusterName } } } }`
+		const q = `query { health { time healthy degraded version services { name version healthy critical latencyMs error databaseInfo { healthy serverName serverVersion clusterName } } } }`
 		var resp healthResponse
 		err := gq.Execute(c, baseURL, q, nil, &resp)
 		Expect(err).NotTo(HaveOccurred())
@@ -48,5 +52,57 @@ var _ = Describe("health query", func() {
 			Expect(s.Name).NotTo(BeEmpty())
 			Expect(s.DatabaseInfo.ServerVersion).NotTo(BeEmpty())
 		}
+
+		var names []string
+		for _, s := range h.Services {
+			names = append(names, s.Name)
+			Expect(s.LatencyMs).To(BeNumerically(">=", 0))
+			if s.Critical {
+				Expect(s.Healthy).To(BeTrue(), "%s is critical: %s", s.Name, s.Error)
+			}
+		}
+		Expect(names).To(ConsistOf("fuzzy", "exact", "phrase", "partial", "ptolemaios", "eukleides", "tracer"))
+	}, SpecTimeout(15*time.Second))
+})
+
+type cacheHealthResponse struct {
+	Health struct {
+		Cache *struct {
+			Backend string `json:"backend"`
+			Entries int    `json:"entries"`
+			Hits    int    `json:"hits"`
+			Misses  int    `json:"misses"`
+		} `json:"cache"`
+	} `json:"health"`
+}
+
+var _ = Describe("health query cache", func() {
+	It("counts a repeated search as a cache hit", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		const healthQ = `query { health { cache { backend entries hits misses } } }`
+		var before cacheHealthResponse
+		Expect(gq.Execute(c, baseURL, healthQ, nil, &before)).To(Succeed())
+		if before.Health.Cache == nil {
+			Skip("response cache is disabled")
+		}
+
+		const searchQ = `query($input: SearchQueryInput!) { fuzzy(input: $input) { results { headword } } }`
+		vars := map[string]any{
+			"input": map[string]any{
+				"word": "ογο",
+				"size": 5,
+			},
+		}
+		var search fuzzyResponse
+		Expect(gq.Execute(c, baseURL, searchQ, vars, &search)).To(Succeed())
+		Expect(gq.Execute(c, baseURL, searchQ, vars, &search)).To(Succeed())
+
+		var after cacheHealthResponse
+		Expect(gq.Execute(c, baseURL, healthQ, nil, &after)).To(Succeed())
+		Expect(after.Health.Cache.Backend).NotTo(BeEmpty())
+		Expect(after.Health.Cache.Hits).To(BeNumerically(">", before.Health.Cache.Hits))
+		Expect(after.Health.Cache.Entries).To(BeNumerically(">", 0))
 	}, SpecTimeout(15*time.Second))
 })
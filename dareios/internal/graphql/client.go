@@ -11,20 +11,44 @@ import (
 )
 
 type request struct {
-	Query     string         `json:"query"`
-	Variables map[string]any `json:"variables,omitempty"`
+	Query      string         `json:"query,omitempty"`
+	Variables  map[string]any `json:"variables,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
 }
 
 type response struct {
 	Data   json.RawMessage `json:"data"`
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors"`
+	Errors []Error         `json:"errors"`
+}
+
+// Error is the first GraphQL error of a response, Execute returns it so specs can inspect its extensions.
+type Error struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("graphql errors: %v", e.Message)
 }
 
 // Execute sends a GraphQL POST request to url and unmarshals the "data" object into v.
 func Execute(ctx context.Context, url, query string, variables map[string]any, v any) error {
-	body, _ := json.Marshal(&request{Query: query, Variables: variables})
+	return send(ctx, url, &request{Query: query, Variables: variables}, v)
+}
+
+// ExecutePersisted sends a persisted query by its sha256 hash. An empty query sends the hash alone.
+func ExecutePersisted(ctx context.Context, url, query, hash string, variables map[string]any, v any) error {
+	return send(ctx, url, &request{
+		Query:     query,
+		Variables: variables,
+		Extensions: map[string]any{
+			"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash},
+		},
+	}, v)
+}
+
+func send(ctx context.Context, url string, gqlRequest *request, v any) error {
+	body, _ := json.Marshal(gqlRequest)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return err
@@ -46,7 +70,7 @@ func Execute(ctx context.Context, url, query string, variables map[string]any, v
 		return err
 	}
 	if len(r.Errors) > 0 {
-		return fmt.Errorf("graphql errors: %v", r.Errors[0].Message)
+		return &r.Errors[0]
 	}
 	if v == nil {
 		return nil
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+// aliasedExact builds a document asking for the same exact search under n aliases.
+func aliasedExact(n int, expand bool) string {
+	var b strings.Builder
+	b.WriteString("query {")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, ` e%d: exact(input: {word: "λόγος", expand: %t}) { results { headword } }`, i, expand)
+	}
+	b.WriteString(" }")
+	return b.String()
+}
+
+var _ = Describe("query limits", func() {
+	It("rejects a document with too many aliases", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		err := gq.Execute(c, baseURL, aliasedExact(200, false), nil, nil)
+
+		var gqlErr *gq.Error
+		Expect(errors.As(err, &gqlErr)).To(BeTrue())
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("code", "ALIAS_LIMIT_EXCEEDED"))
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("limit", "alias"))
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("actual", BeNumerically("==", 200)))
+	}, SpecTimeout(20*time.Second))
+
+	It("weighs expanded exact searches against the complexity limit", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		err := gq.Execute(c, baseURL, aliasedExact(10, true), nil, nil)
+
+		var gqlErr *gq.Error
+		Expect(errors.As(err, &gqlErr)).To(BeTrue())
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("code", "COMPLEXITY_LIMIT_EXCEEDED"))
+		Expect(gqlErr.Extensions).To(HaveKeyWithValue("limit", "complexity"))
+	}, SpecTimeout(20*time.Second))
+
+	It("accepts a few aliased searches", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		var resp map[string]any
+		Expect(gq.Execute(c, baseURL, aliasedExact(3, false), nil, &resp)).To(Succeed())
+		Expect(resp).To(HaveLen(3))
+	}, SpecTimeout(20*time.Second))
+})
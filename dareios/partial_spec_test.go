@@ -23,8 +23,11 @@ type partialResponse struct {
 			LinkedWord string `json:"linkedWord"`
 		} `json:"results"`
 		PageInfo struct {
-			Page  int `json:"page"`
-			Total int `json:"total"`
+			Page        int     `json:"page"`
+			Size        int     `json:"size"`
+			Total       int     `json:"total"`
+			HasNextPage bool    `json:"hasNextPage"`
+			NextCursor  *string `json:"nextCursor"`
 		} `json:"pageInfo"`
 	} `json:"partial"`
 }
@@ -84,4 +87,49 @@ var _ = Describe("partial query", func() {
 			Expect(r.Headword).NotTo(BeEmpty())
 		}
 	}, SpecTimeout(20*time.Second))
+
+	It("pages through partial results by page number and by cursor", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		const q = `query($input: SearchQueryInput!) { partial(input: $input) {
+		results {
+			headword
+		}
+		pageInfo{
+			page
+			size
+			total
+			hasNextPage
+			nextCursor
+		}
+	}
+}`
+		fetch := func(input map[string]any) partialResponse {
+			var resp partialResponse
+			err := gq.Execute(c, baseURL, q, map[string]any{"input": input}, &resp)
+			Expect(err).NotTo(HaveOccurred())
+			return resp
+		}
+
+		first := fetch(map[string]any{"word": "λόγο", "size": 2}).Partial
+		Expect(first.PageInfo.Page).To(Equal(1))
+		Expect(first.PageInfo.Size).To(Equal(2))
+		Expect(len(first.Results)).To(BeNumerically("<=", 2))
+		Expect(first.PageInfo.HasNextPage).To(Equal(first.PageInfo.Total > 2))
+		if !first.PageInfo.HasNextPage {
+			Skip("dataset has a single page for this word")
+		}
+		Expect(first.PageInfo.NextCursor).NotTo(BeNil())
+
+		second := fetch(map[string]any{"word": "λόγο", "size": 2, "page": 2}).Partial
+		Expect(second.PageInfo.Page).To(Equal(2))
+		Expect(second.Results).NotTo(BeEmpty())
+		Expect(second.PageInfo.Total).To(Equal(first.PageInfo.Total))
+
+		// the cursor continues where page one stopped, just like page two does
+		after := fetch(map[string]any{"word": "λόγο", "size": 2, "after": *first.PageInfo.NextCursor}).Partial
+		Expect(after.Results).To(Equal(second.Results))
+		Expect(after.PageInfo.HasNextPage).To(Equal(second.PageInfo.HasNextPage))
+	}, SpecTimeout(20*time.Second))
 })
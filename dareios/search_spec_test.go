@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+type searchResponse struct {
+	Search struct {
+		Results []struct {
+			Rank     int      `json:"rank"`
+			Strategy string   `json:"strategy"`
+			FoundBy  []string `json:"foundBy"`
+			Lemma    struct {
+				ID       *string `json:"id"`
+				Headword string  `json:"headword"`
+			} `json:"lemma"`
+		} `json:"results"`
+		Timings []struct {
+			Strategy   string  `json:"strategy"`
+			DurationMs int     `json:"durationMs"`
+			Results    int     `json:"results"`
+			Total      int     `json:"total"`
+			Error      *string `json:"error"`
+		} `json:"timings"`
+	} `json:"search"`
+}
+
+var _ = Describe("search query", func() {
+	It("merges every strategy into one ranked list", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		const q = `query($input: UnifiedSearchInput!) { search(input: $input) {
+		results {
+			rank
+			strategy
+			foundBy
+			lemma {
+				id
+				headword
+			}
+		}
+		timings {
+			strategy
+			durationMs
+			results
+			total
+			error
+		}
+	}
+}`
+		vars := map[string]any{
+			"input": map[string]any{
+				"word": "λόγος",
+				"size": 5,
+			},
+		}
+		var resp searchResponse
+		err := gq.Execute(c, baseURL, q, vars, &resp)
+		Expect(err).NotTo(HaveOccurred())
+
+		s := resp.Search
+		Expect(s.Timings).To(HaveLen(4))
+		for _, timing := range s.Timings {
+			Expect(timing.DurationMs).To(BeNumerically(">=", 0))
+			Expect(timing.Results).To(BeNumerically("<=", 5))
+		}
+
+		seen := map[string]bool{}
+		for i, result := range s.Results {
+			Expect(result.Rank).To(Equal(i + 1))
+			Expect(result.FoundBy).To(ContainElement(result.Strategy))
+
+			key := result.Lemma.Headword
+			if result.Lemma.ID != nil && *result.Lemma.ID != "" {
+				key = *result.Lemma.ID
+			}
+			Expect(seen).NotTo(HaveKey(key))
+			seen[key] = true
+		}
+	}, SpecTimeout(20*time.Second))
+
+	It("only asks the requested strategies", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		const q = `query($input: UnifiedSearchInput!) { search(input: $input) {
+		results {
+			strategy
+		}
+		timings {
+			strategy
+		}
+	}
+}`
+		vars := map[string]any{
+			"input": map[string]any{
+				"word":       "λόγος",
+				"strategies": []string{"EXACT", "FUZZY"},
+			},
+		}
+		var resp searchResponse
+		err := gq.Execute(c, baseURL, q, vars, &resp)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(resp.Search.Timings).To(HaveLen(2))
+		for _, result := range resp.Search.Results {
+			Expect(result.Strategy).To(BeElementOf("EXACT", "FUZZY"))
+		}
+	}, SpecTimeout(20*time.Second))
+})
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gq "github.com/odysseia-greek/makedonia/dareios/internal/graphql"
+)
+
+type trendingResponse struct {
+	Trending []struct {
+		ServiceName     string  `json:"serviceName"`
+		Word            string  `json:"word"`
+		RecentCount     int     `json:"recentCount"`
+		BaselineAverage float64 `json:"baselineAverage"`
+		Score           float64 `json:"score"`
+	} `json:"trending"`
+}
+
+var _ = Describe("trending query", func() {
+	It("returns words ordered by score within the limit", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		const q = `query { trending(window: LAST_24H, limit: 5, minCount: 1) { serviceName word recentCount baselineAverage score } }`
+		var resp trendingResponse
+		err := gq.Execute(c, baseURL, q, nil, &resp)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(len(resp.Trending)).To(BeNumerically("<=", 5))
+		for i, t := range resp.Trending {
+			Expect(t.Word).NotTo(BeEmpty())
+			Expect(t.RecentCount).To(BeNumerically(">=", 1))
+			Expect(t.BaselineAverage).To(BeNumerically(">=", 0))
+			if i > 0 {
+				Expect(resp.Trending[i-1].Score).To(BeNumerically(">=", t.Score))
+			}
+		}
+	}, SpecTimeout(15*time.Second))
+
+	It("rejects a window longer than the baseline", func(ctx context.Context) {
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		const q = `query { trending(window: LAST_30D) { word } }`
+		var resp trendingResponse
+		err := gq.Execute(c, baseURL, q, nil, &resp)
+		Expect(err).To(HaveOccurred())
+	}, SpecTimeout(15*time.Second))
+})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +29,16 @@ const (
 	envMinNGram     string = "MIN_NGRAM"
 )
 
+// DefaultReseedChannel is where a single alexandros replica follows a reseed. With more replicas
+// DEMOKRITOS_RESEED_CHANNELS lists the channel of each of them, comma separated.
+const DefaultReseedChannel = "demokritos-reseeds"
+
+// The statuses put on the reseed channel.
+const (
+	ReseedStarted   = "started"
+	ReseedCompleted = "completed"
+)
+
 func CreateNewConfig() (*DemokritosHandler, error) {
 	tls := config.BoolFromEnv(config.EnvTlSKey)
 
@@ -100,6 +111,12 @@ func CreateNewConfig() (*DemokritosHandler, error) {
 	}
 
 	channel := config.StringFromEnv(config.EnvJobName, config.DefaultJobName)
+	var reseedChannels []string
+	for _, reseedChannel := range strings.Split(config.StringFromEnv("DEMOKRITOS_RESEED_CHANNELS", DefaultReseedChannel), ",") {
+		if reseedChannel = strings.TrimSpace(reseedChannel); reseedChannel != "" {
+			reseedChannels = append(reseedChannels, reseedChannel)
+		}
+	}
 
 	var buf bytes.Buffer
 
@@ -115,5 +132,7 @@ func CreateNewConfig() (*DemokritosHandler, error) {
 		Ambassador: ambassador,
 		Eupalinos:  queue,
 		Channel:    channel,
+
+		ReseedChannels: reseedChannels,
 	}, nil
 }
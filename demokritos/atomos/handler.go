@@ -2,12 +2,15 @@ package atomos
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
 
+	"github.com/google/uuid"
 	elastic "github.com/odysseia-greek/agora/aristoteles"
+	pbe "github.com/odysseia-greek/agora/eupalinos/proto"
 	"github.com/odysseia-greek/agora/eupalinos/stomion"
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/agora/plato/models"
@@ -28,6 +31,25 @@ type DemokritosHandler struct {
 	PolicyName string
 	Buf        bytes.Buffer
 	Ambassador *diplomat.ClientAmbassador
+	// Channel only ever gets "completed", the jobs waiting on demokritos read it as such. ReseedChannels follow the
+	// whole reseed for alexandros, "started" as well as "completed", one channel per replica.
+	ReseedChannels []string
+}
+
+// AnnounceReseed puts a reseed status on every reseed channel. Eupalinos hands a message to a single reader, so each
+// alexandros replica needs a channel of its own to hear about the reseed. A failure is only logged, the dictionary is
+// reloaded anyway.
+func (d *DemokritosHandler) AnnounceReseed(ctx context.Context, status string) {
+	for _, channel := range d.ReseedChannels {
+		_, err := d.Eupalinos.EnqueueMessage(ctx, &pbe.Epistello{
+			Id:      uuid.New().String(),
+			Data:    status,
+			Channel: channel,
+		})
+		if err != nil {
+			logging.Error(fmt.Sprintf("failed to announce reseed %s on %s: %s", status, channel, err.Error()))
+		}
+	}
 }
 
 func (d *DemokritosHandler) DeleteIndexAtStartUp() error {
@@ -68,6 +90,9 @@ func (d *DemokritosHandler) AddDirectoryToElastic(lemmas []hetairoi.LemmaSource,
 
 	for _, word := range lemmas {
 		currBatch++
+		if word.ID == "" {
+			word.ID = uuid.New().String()
+		}
 
 		meta := []byte(fmt.Sprintf(`{ "index": {} }%s`, "\n"))
 		jsonifiedWord, _ := json.Marshal(word)
@@ -29,6 +29,10 @@ func dictionaryIndex(min, max int, policyName string) map[string]interface{} {
 		},
 		"mappings": map[string]interface{}{
 			"properties": map[string]interface{}{
+				// unique per lemma, breaks ties between equal scores when paging
+				"id": map[string]interface{}{
+					"type": "keyword",
+				},
 				"greek": map[string]interface{}{
 					"type":     "text",
 					"analyzer": "greek_analyzer",
@@ -51,3 +51,5 @@ require (
 	google.golang.org/protobuf v1.36.11 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/odysseia-greek/makedonia/filippos => ../filippos
@@ -58,6 +58,9 @@ func main() {
 		log.Fatal(err)
 	}
 
+	// subscribers of alexandros are told a reseed is underway, "completed" follows once every file is in
+	handler.AnnounceReseed(context.Background(), atomos.ReseedStarted)
+
 	err = handler.DeleteIndexAtStartUp()
 	if err != nil {
 		log.Fatal(err)
@@ -159,6 +162,7 @@ func main() {
 		Channel: handler.Channel,
 	}
 	_, err = handler.Eupalinos.EnqueueMessage(ctx, msg)
+	handler.AnnounceReseed(ctx, atomos.ReseedCompleted)
 
 	logging.Info(fmt.Sprintf("created: %s", strconv.Itoa(handler.Created)))
 	logging.Info(fmt.Sprintf("words found in sullego: %s", strconv.Itoa(documents)))
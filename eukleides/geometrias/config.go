@@ -2,31 +2,130 @@ package geometrias
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/logging"
-	aristophanes "github.com/odysseia-greek/attike/aristophanes/comedy"
+	"github.com/odysseia-greek/makedonia/filippos/themelios"
 )
 
+const (
+	envStorageBackend       string = "STORAGE_BACKEND"
+	envStoragePath          string = "STORAGE_PATH"
+	envFlushInterval        string = "FLUSH_INTERVAL"
+	envSessionIdleTTL       string = "SESSION_IDLE_TTL"
+	envSessionMaxKeys       string = "SESSION_MAX_KEYS"
+	envJanitorInterval      string = "SESSION_JANITOR_INTERVAL"
+	envSessionRetention     string = "SESSION_RETENTION_DAYS"
+	envSessionMode          string = "SESSION_MODE"
+	envSessionHashKey       string = "SESSION_HASH_KEY"
+	envWorkers              string = "WORKERS"
+	envWorkerQueue          string = "WORKER_QUEUE"
+	defaultStorageBackend   string = StorageMemory
+	defaultStoragePath      string = "/tmp/badger/eukleides"
+	defaultFlushInterval    string = "1m"
+	defaultSessionIdleTTL   string = "72h"
+	defaultSessionMaxKeys   string = "100000"
+	defaultJanitorInterval  string = "5m"
+	defaultSessionRetention string = "0"
+	defaultSessionMode      string = SessionPlain
+	defaultWorkers          string = "4"
+	defaultWorkerQueue      string = "1024"
+)
+
+// CreateNewConfig reads and validates the whole environment before it opens the storage or starts any goroutine, so a
+// bad setting never leaves the Badger directory locked behind it.
 func CreateNewConfig(ctx context.Context) (*CounterServiceImpl, error) {
-	tracer, err := aristophanes.NewClientTracer(aristophanes.DefaultAddress)
-	healthy := tracer.WaitForHealthyState()
-	if !healthy {
-		logging.Error("tracing service not ready - restarting seems the only option")
-		os.Exit(1)
+	backend := config.StringFromEnv(envStorageBackend, defaultStorageBackend)
+	path := config.StringFromEnv(envStoragePath, defaultStoragePath)
+
+	flushInterval, err := time.ParseDuration(config.StringFromEnv(envFlushInterval, defaultFlushInterval))
+	if err != nil {
+		return nil, err
+	}
+
+	idleTTL, err := time.ParseDuration(config.StringFromEnv(envSessionIdleTTL, defaultSessionIdleTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	maxKeys, err := strconv.Atoi(config.StringFromEnv(envSessionMaxKeys, defaultSessionMaxKeys))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", envSessionMaxKeys, err)
+	}
+
+	janitorInterval, err := time.ParseDuration(config.StringFromEnv(envJanitorInterval, defaultJanitorInterval))
+	if err != nil {
+		return nil, err
+	}
+
+	retentionDays, err := strconv.Atoi(config.StringFromEnv(envSessionRetention, defaultSessionRetention))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", envSessionRetention, err)
+	}
+	retention := time.Duration(retentionDays) * 24 * time.Hour
+
+	sessionMode := config.StringFromEnv(envSessionMode, defaultSessionMode)
+	hasher, err := NewSessionHasher(sessionMode, os.Getenv(envSessionHashKey))
+	if err != nil {
+		return nil, err
 	}
 
-	streamer, err := tracer.Chorus(ctx)
+	workers, err := strconv.Atoi(config.StringFromEnv(envWorkers, defaultWorkers))
 	if err != nil {
-		logging.Error(err.Error())
+		return nil, fmt.Errorf("invalid %s: %w", envWorkers, err)
 	}
+	if workers < 1 {
+		return nil, fmt.Errorf("invalid %s: at least one worker is required", envWorkers)
+	}
+
+	queueSize, err := strconv.Atoi(config.StringFromEnv(envWorkerQueue, defaultWorkerQueue))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", envWorkerQueue, err)
+	}
+	if queueSize < 0 {
+		return nil, fmt.Errorf("invalid %s: the queue cannot be negative", envWorkerQueue)
+	}
+
+	deps, err := themelios.Bootstrap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := NewStorage(backend, path)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := NewStoreWithStorage(storage)
+	if err != nil {
+		storage.Close()
+		return nil, err
+	}
+
+	store.StartFlushing(ctx, flushInterval)
+	logging.System(fmt.Sprintf("counters stored in %s backend, flushing every %s", backend, flushInterval))
+
+	store.LimitSessions(idleTTL, maxKeys)
+	store.RetainSessions(retention)
+	if idleTTL > 0 || retention > 0 {
+		store.StartJanitor(ctx, janitorInterval)
+	}
+	logging.System(fmt.Sprintf("session counters idle after %s, kept for at most %d days, capped at %d keys, janitor runs every %s", idleTTL, retentionDays, maxKeys, janitorInterval))
+
+	store.PseudonymiseSessions(hasher)
+	logging.System(fmt.Sprintf("session ids stored as %s", sessionMode))
 
-	version := os.Getenv(config.EnvVersion)
+	logging.System(fmt.Sprintf("applying counts with %d workers and a queue of %d batches", workers, queueSize))
 
 	return &CounterServiceImpl{
-		Streamer: streamer,
-		Version:  version,
-		store:    NewStore(),
+		Streamer: deps.Streamer,
+		Version:  deps.Version,
+		store:    store,
+		workers:  newWorkerPool(store, workers, queueSize),
+		ending:   make(chan struct{}),
 	}, nil
 }
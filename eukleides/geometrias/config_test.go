@@ -0,0 +1,32 @@
+package geometrias
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNewConfig(t *testing.T) {
+	for env, value := range map[string]string{
+		envWorkers:     "0",
+		envWorkerQueue: "-1",
+		envSessionMode: "rot13",
+	} {
+		t.Run(env, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "eukleides")
+			t.Setenv(envStorageBackend, StorageBadger)
+			t.Setenv(envStoragePath, path)
+			t.Setenv(env, value)
+
+			_, err := CreateNewConfig(context.Background())
+			assert.NotNil(t, err)
+
+			// the storage was never opened, so nothing holds the directory lock
+			storage, err := NewStorage(StorageBadger, path)
+			assert.Nil(t, err)
+			assert.Nil(t, storage.Close())
+		})
+	}
+}
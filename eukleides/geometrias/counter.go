@@ -2,58 +2,226 @@ package geometrias
 
 import (
 	"context"
+	"errors"
 	"io"
 	"time"
 
 	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
 )
 
 func (c *CounterServiceImpl) Health(ctx context.Context, request *pb.HealthRequest) (*pb.HealthResponse, error) {
+	stats := c.store.SessionStats()
 	return &pb.HealthResponse{
-		Healthy: true,
+		Healthy: c.store.Healthy(),
 		Time:    time.Now().String(),
 		Version: c.Version,
+		Sessions: &pb.SessionStats{
+			Active:          int64(stats.Active),
+			EvictedIdle:     stats.EvictedIdle,
+			EvictedCapacity: stats.EvictedCapacity,
+			Expired:         stats.Expired,
+			Forgotten:       stats.Forgotten,
+		},
 	}, nil
 }
 
-// Server-side implementations (barebones) for Eukleides service
-// CreateNewEntry receives a stream of CountCreationRequestSet and returns an ack.
+// Healthy drives the grpc.health.v1 status from the storage the counters are flushed to.
+func (c *CounterServiceImpl) Healthy(ctx context.Context) bool {
+	return c.store.Healthy()
+}
+
+// Close waits for the queued batches to be applied, flushes all counters to storage and ends the trace stream; call
+// it once the server has stopped.
+func (c *CounterServiceImpl) Close() error {
+	c.workers.close()
+	err := c.store.Close()
+	if c.Streamer != nil {
+		err = errors.Join(err, c.Streamer.CloseSend())
+	}
+	return err
+}
+
+// errStreamsEnded tells the ingesters of alexandros to reconnect, to another replica while this one shuts down.
+var errStreamsEnded = hamartia.Unavailable(hamartia.ReasonShuttingDown, "eukleides is shutting down")
+
+// EndStreams makes the open CreateNewEntry and CreateNewEntryStream streams return once the batches they received are
+// acked, and refuses new ones, so a graceful stop does not wait for ingesters that never close their stream.
+func (c *CounterServiceImpl) EndStreams() {
+	c.endingOnce.Do(func() { close(c.ending) })
+}
+
+// CreateNewEntry applies every received batch through the worker pool and, once the client closes the stream,
+// acks with the number of events that were applied.
 func (c *CounterServiceImpl) CreateNewEntry(stream pb.Eukleides_CreateNewEntryServer) error {
+	var applied int64
+	err := c.receive(stream.Context(), stream.Recv, func(batchID string, n int64) error {
+		applied += n
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.CountStreamResponse{Ack: "Received", Applied: applied})
+}
+
+// CreateNewEntryStream acks each batch by id as soon as it has been applied.
+func (c *CounterServiceImpl) CreateNewEntryStream(stream pb.Eukleides_CreateNewEntryStreamServer) error {
+	return c.receive(stream.Context(), stream.Recv, func(batchID string, n int64) error {
+		return stream.Send(&pb.CountBatchAck{BatchId: batchID, Applied: n})
+	})
+}
+
+type submitted struct {
+	batchID string
+	applied <-chan int64
+}
+
+// receive hands batches to the worker pool until the client is done or EndStreams is called and calls ack for every
+// batch in the order the batches arrived, whichever worker applied them.
+func (c *CounterServiceImpl) receive(ctx context.Context, recv func() (*pb.CountCreationRequestSet, error), ack func(batchID string, applied int64) error) error {
+	select {
+	case <-c.ending:
+		return errStreamsEnded
+	default:
+	}
+
+	pending := make(chan submitted, 64)
+	acked := make(chan error, 1)
+	go func() {
+		var ackErr error
+		for s := range pending {
+			applied := <-s.applied
+			if ackErr == nil {
+				ackErr = ack(s.batchID, applied)
+			}
+		}
+		acked <- ackErr
+	}()
+
+	type received struct {
+		set *pb.CountCreationRequestSet
+		err error
+	}
+	// Recv blocks until the client sends, it runs apart so EndStreams can interrupt the wait. Once the handler
+	// returned the stream context is done and the pending Recv ends with it.
+	sets := make(chan received)
+	go func() {
+		for {
+			set, err := recv()
+			select {
+			case sets <- received{set: set, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	var recvErr error
 	for {
-		in, err := stream.Recv()
-		if err == io.EOF {
-			return stream.SendAndClose(&pb.CountStreamResponse{Ack: "Received"})
+		var in received
+		select {
+		case in = <-sets:
+		case <-c.ending:
+			recvErr = errStreamsEnded
 		}
-		if err != nil {
-			return err
+		if recvErr != nil || in.err == io.EOF {
+			break
+		}
+		if in.err != nil {
+			recvErr = in.err
+			break
 		}
 
-		go c.createOrUpdate(in)
+		applied, err := c.workers.submit(ctx, in.set)
+		if err != nil {
+			recvErr = err
+			break
+		}
+		pending <- submitted{batchID: in.set.BatchId, applied: applied}
 	}
-}
 
-func (c *CounterServiceImpl) createOrUpdate(request *pb.CountCreationRequestSet) {
-	now := time.Now().UTC()
-	for _, req := range request.Request {
-		c.store.Inc(req.SessionId, req.ServiceName, req.Word, now)
+	close(pending)
+	if ackErr := <-acked; recvErr == nil {
+		recvErr = ackErr
 	}
+	return recvErr
 }
 
-// RetrieveTopFive returns an empty TopFiveResponse for now (to be implemented later).
+// RetrieveTopFive returns the five most searched words across all services.
 func (c *CounterServiceImpl) RetrieveTopFive(ctx context.Context, in *pb.TopFiveRequest) (*pb.TopFiveResponse, error) {
 	return &pb.TopFiveResponse{TopFive: c.store.TopFiveGlobal()}, nil
 }
 
-// RetrieveTopFiveService returns an empty TopFive placeholder (to be implemented later).
+// RetrieveTopFiveService returns the most searched word of the named service, or an empty TopFive when it has none.
 func (c *CounterServiceImpl) RetrieveTopFiveService(ctx context.Context, in *pb.TopFiveServiceRequest) (*pb.TopFive, error) {
-	top := c.store.TopFiveByService(in.Name)
+	top, _ := c.store.Top(TopQuery{Service: in.Name, Limit: 1})
 	if len(top) == 0 {
 		return &pb.TopFive{}, nil
 	}
 	return top[0], nil
 }
 
-// RetrieveTopFiveForSession returns an empty TopFiveResponse for the given session (to be implemented later).
+// RetrieveTopFiveWindow ranks words by how often they were searched within the requested window.
+func (c *CounterServiceImpl) RetrieveTopFiveWindow(ctx context.Context, in *pb.TopFiveWindowRequest) (*pb.TopFiveResponse, error) {
+	span, windowed := windowSpan(in.Window)
+	if !windowed {
+		if in.ServiceName != "" {
+			return &pb.TopFiveResponse{TopFive: c.store.TopFiveByService(in.ServiceName)}, nil
+		}
+		return &pb.TopFiveResponse{TopFive: c.store.TopFiveGlobal()}, nil
+	}
+
+	return &pb.TopFiveResponse{TopFive: c.store.TopFiveWindow(span, in.ServiceName, time.Now().UTC())}, nil
+}
+
+// RetrieveTopFiveForSession returns the five words the given session searched most.
 func (c *CounterServiceImpl) RetrieveTopFiveForSession(ctx context.Context, in *pb.TopFiveSessionRequest) (*pb.TopFiveResponse, error) {
 	return &pb.TopFiveResponse{TopFive: c.store.TopFiveForSession(in.SessionId)}, nil
 }
+
+// RetrieveTop pages through the ranking, optionally narrowed to a service, search type or session.
+func (c *CounterServiceImpl) RetrieveTop(ctx context.Context, in *pb.TopRequest) (*pb.TopResponse, error) {
+	return c.retrievePage(TopQuery{
+		Service:    in.ServiceName,
+		SearchType: in.SearchType,
+		Session:    in.SessionId,
+	}, in.Limit, in.Offset)
+}
+
+// RetrieveZeroResults pages through the words whose searches came back empty, i.e. what the dictionary lacks.
+func (c *CounterServiceImpl) RetrieveZeroResults(ctx context.Context, in *pb.ZeroResultsRequest) (*pb.TopResponse, error) {
+	return c.retrievePage(TopQuery{
+		Service:     in.ServiceName,
+		Language:    in.Language,
+		ZeroResults: true,
+	}, in.Limit, in.Offset)
+}
+
+func (c *CounterServiceImpl) retrievePage(query TopQuery, limit, offset int32) (*pb.TopResponse, error) {
+	if limit < 0 || offset < 0 {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidPage, errors.New("limit and offset must not be negative"))
+	}
+
+	if limit == 0 {
+		limit = defaultTopLimit
+	}
+	if limit > maxTopLimit {
+		limit = maxTopLimit
+	}
+
+	query.Limit = int(limit)
+	query.Offset = int(offset)
+	entries, total := c.store.Top(query)
+
+	return &pb.TopResponse{
+		Entries: entries,
+		Total:   int64(total),
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package geometrias
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEntryServer replays sets to CreateNewEntry and CreateNewEntryStream and records what is sent back.
+type fakeEntryServer struct {
+	grpc.ServerStream
+	sets     []*pb.CountCreationRequestSet
+	acks     []*pb.CountBatchAck
+	response *pb.CountStreamResponse
+}
+
+func (f *fakeEntryServer) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeEntryServer) Recv() (*pb.CountCreationRequestSet, error) {
+	if len(f.sets) == 0 {
+		return nil, io.EOF
+	}
+	set := f.sets[0]
+	f.sets = f.sets[1:]
+	return set, nil
+}
+
+func (f *fakeEntryServer) Send(ack *pb.CountBatchAck) error {
+	f.acks = append(f.acks, ack)
+	return nil
+}
+
+func (f *fakeEntryServer) SendAndClose(response *pb.CountStreamResponse) error {
+	f.response = response
+	return nil
+}
+
+// blockingEntryServer replays its sets and then blocks like a client that keeps the stream open.
+type blockingEntryServer struct {
+	fakeEntryServer
+	ctx context.Context
+}
+
+func (b *blockingEntryServer) Context() context.Context {
+	return b.ctx
+}
+
+func (b *blockingEntryServer) Recv() (*pb.CountCreationRequestSet, error) {
+	if len(b.sets) > 0 {
+		return b.fakeEntryServer.Recv()
+	}
+	<-b.ctx.Done()
+	return nil, b.ctx.Err()
+}
+
+func newTestCounter() *CounterServiceImpl {
+	store := NewStore()
+	return &CounterServiceImpl{store: store, workers: newWorkerPool(store, 4, 2), ending: make(chan struct{})}
+}
+
+func batch(id string, words ...string) *pb.CountCreationRequestSet {
+	set := &pb.CountCreationRequestSet{BatchId: id}
+	for _, word := range words {
+		set.Request = append(set.Request, &pb.CountCreationRequest{Word: word, ServiceName: "exact", SearchType: "exact", SessionId: "s1"})
+	}
+	return set
+}
+
+func TestCreateNewEntry(t *testing.T) {
+	t.Run("AcksAppliedEvents", func(t *testing.T) {
+		sut := newTestCounter()
+		stream := &fakeEntryServer{sets: []*pb.CountCreationRequestSet{
+			batch("", "λόγος", "λόγος"),
+			batch("", "ἀγγέλλω", ""),
+		}}
+
+		err := sut.CreateNewEntry(stream)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), stream.response.Applied)
+
+		// applied before the ack, so the counts are visible right away
+		top := sut.store.TopFiveGlobal()
+		assert.Equal(t, "λόγος", top[0].Word)
+		assert.Equal(t, int64(2), top[0].Count)
+	})
+
+	t.Run("AcksEveryBatchInOrder", func(t *testing.T) {
+		sut := newTestCounter()
+		stream := &fakeEntryServer{}
+		for i := 0; i < 20; i++ {
+			stream.sets = append(stream.sets, batch(fmt.Sprintf("batch-%d", i), "λόγος"))
+		}
+
+		err := sut.CreateNewEntryStream(stream)
+		assert.Nil(t, err)
+		assert.Len(t, stream.acks, 20)
+		for i, ack := range stream.acks {
+			assert.Equal(t, fmt.Sprintf("batch-%d", i), ack.BatchId)
+			assert.Equal(t, int64(1), ack.Applied)
+		}
+	})
+
+	t.Run("EndStreamsAcksWhatArrived", func(t *testing.T) {
+		sut := newTestCounter()
+		stream := &blockingEntryServer{
+			fakeEntryServer: fakeEntryServer{sets: []*pb.CountCreationRequestSet{batch("batch-1", "λόγος")}},
+			ctx:             t.Context(),
+		}
+
+		done := make(chan error)
+		go func() { done <- sut.CreateNewEntryStream(stream) }()
+		assert.Eventually(t, func() bool {
+			return len(sut.store.TopFiveGlobal()) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		// the client keeps the stream open, EndStreams ends it anyway
+		sut.EndStreams()
+		err := <-done
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		reason, _ := hamartia.Reason(err)
+		assert.Equal(t, hamartia.ReasonShuttingDown, reason)
+		assert.Len(t, stream.acks, 1)
+
+		assert.Equal(t, codes.Unavailable, status.Code(sut.CreateNewEntryStream(&fakeEntryServer{})))
+	})
+
+	t.Run("RejectsBatchesAfterClose", func(t *testing.T) {
+		sut := newTestCounter()
+		assert.Nil(t, sut.Close())
+
+		err := sut.CreateNewEntry(&fakeEntryServer{sets: []*pb.CountCreationRequestSet{batch("", "λόγος")}})
+		assert.NotNil(t, err)
+	})
+}
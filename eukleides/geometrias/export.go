@@ -0,0 +1,92 @@
+package geometrias
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+)
+
+const (
+	scopeGlobal  string = "global"
+	scopeSession string = "session"
+)
+
+// ExportQuery selects the counters to export; empty filters match everything.
+type ExportQuery struct {
+	Global  bool
+	Session bool
+	Service string
+	Since   time.Time
+}
+
+func (q ExportQuery) include(service string, lastUsed time.Time) bool {
+	if q.Service != "" && service != q.Service {
+		return false
+	}
+	return q.Since.IsZero() || !lastUsed.Before(q.Since)
+}
+
+// Export calls send for every counter matching q. It works on a snapshot, so a slow reader never holds the store lock.
+func (s *Store) Export(q ExportQuery, send func(*pb.ExportedCounter) error) error {
+	snapshot := s.Snapshot()
+
+	if q.Global {
+		for _, e := range snapshot.Global {
+			if !q.include(e.Service, e.LastUsed) {
+				continue
+			}
+			if err := send(exportedCounter(scopeGlobal, "", e.Service, e.Word, e.Counter)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if q.Session {
+		for _, e := range snapshot.Session {
+			if !q.include(e.Service, e.LastUsed) {
+				continue
+			}
+			if err := send(exportedCounter(scopeSession, e.Session, e.Service, e.Word, e.Counter)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func exportedCounter(scope, session, service, word string, c Counter) *pb.ExportedCounter {
+	return &pb.ExportedCounter{
+		Scope:       scope,
+		SessionId:   session,
+		ServiceName: service,
+		Word:        word,
+		Count:       c.Count,
+		LastUsed:    c.LastUsed.UTC().Format(time.RFC3339Nano),
+		Hits:        c.Hits,
+		ZeroResults: c.ZeroResults,
+		SearchTypes: c.SearchTypes,
+		Languages:   c.Languages,
+	}
+}
+
+// ExportCounters streams every counter matching the request, global counters first.
+func (c *CounterServiceImpl) ExportCounters(in *pb.ExportRequest, stream pb.Eukleides_ExportCountersServer) error {
+	query := ExportQuery{
+		Global:  in.Scope != pb.ExportScope_EXPORT_SESSION,
+		Session: in.Scope != pb.ExportScope_EXPORT_GLOBAL,
+		Service: in.ServiceName,
+	}
+
+	if in.Since != "" {
+		since, err := time.Parse(time.RFC3339, in.Since)
+		if err != nil {
+			return hamartia.InvalidArgument(hamartia.ReasonInvalidArgument, fmt.Errorf("since must be an RFC3339 time: %w", err))
+		}
+		query.Since = since
+	}
+
+	return c.store.Export(query, stream.Send)
+}
@@ -3,20 +3,29 @@ package geometrias
 import (
 	"context"
 	"fmt"
-	"time"
+	"sync"
 
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type CounterService interface {
 	WaitForHealthyState() bool
 	CreateNewEntry(ctx context.Context) (pb.Eukleides_CreateNewEntryClient, error)
+	CreateNewEntryStream(ctx context.Context) (pb.Eukleides_CreateNewEntryStreamClient, error)
 	RetrieveTopFive(ctx context.Context, in *pb.TopFiveRequest) (*pb.TopFiveResponse, error)
 	RetrieveTopFiveService(ctx context.Context, in *pb.TopFiveServiceRequest) (*pb.TopFive, error)
 	RetrieveTopFiveForSession(ctx context.Context, in *pb.TopFiveSessionRequest) (*pb.TopFiveResponse, error)
+	RetrieveTopFiveWindow(ctx context.Context, in *pb.TopFiveWindowRequest) (*pb.TopFiveResponse, error)
+	RetrieveTop(ctx context.Context, in *pb.TopRequest) (*pb.TopResponse, error)
+	RetrieveZeroResults(ctx context.Context, in *pb.ZeroResultsRequest) (*pb.TopResponse, error)
+	RetrieveTrending(ctx context.Context, in *pb.TrendingRequest) (*pb.TrendingResponse, error)
+	ExportCounters(ctx context.Context, in *pb.ExportRequest) (pb.Eukleides_ExportCountersClient, error)
+	ForgetSession(ctx context.Context, in *pb.ForgetSessionRequest) (*pb.ForgetSessionResponse, error)
 }
 
 const (
@@ -26,7 +35,11 @@ const (
 type CounterServiceImpl struct {
 	Version  string
 	store    *Store
+	workers  *workerPool
 	Streamer arv1.TraceService_ChorusClient
+	// ending is closed by EndStreams, see counter.go
+	ending     chan struct{}
+	endingOnce sync.Once
 	pb.UnimplementedEukleidesServer
 }
 type CounterServiceClient struct {
@@ -34,6 +47,7 @@ type CounterServiceClient struct {
 }
 type CounterClient struct {
 	counter pb.EukleidesClient
+	health  grpc_health_v1.HealthClient
 }
 
 func NewEukleidesClient(address string) (*CounterClient, error) {
@@ -45,24 +59,11 @@ func NewEukleidesClient(address string) (*CounterClient, error) {
 		return nil, fmt.Errorf("failed to connect to tracing service: %w", err)
 	}
 	client := pb.NewEukleidesClient(conn)
-	return &CounterClient{counter: client}, nil
+	return &CounterClient{counter: client, health: grpc_health_v1.NewHealthClient(conn)}, nil
 }
 
 func (m *CounterClient) WaitForHealthyState() bool {
-	timeout := 30 * time.Second
-	checkInterval := 1 * time.Second
-	endTime := time.Now().Add(timeout)
-
-	for time.Now().Before(endTime) {
-		response, err := m.Health(context.Background(), &pb.HealthRequest{})
-		if err == nil && response.Healthy {
-			return true
-		}
-
-		time.Sleep(checkInterval)
-	}
-
-	return false
+	return hygieia.WaitForServing(m.health, pb.Eukleides_ServiceDesc.ServiceName, hygieia.DefaultWaitTimeout)
 }
 
 func (m *CounterClient) Health(ctx context.Context, request *pb.HealthRequest) (*pb.HealthResponse, error) {
@@ -73,6 +74,10 @@ func (m *CounterClient) CreateNewEntry(ctx context.Context) (pb.Eukleides_Create
 	return m.counter.CreateNewEntry(ctx)
 }
 
+func (m *CounterClient) CreateNewEntryStream(ctx context.Context) (pb.Eukleides_CreateNewEntryStreamClient, error) {
+	return m.counter.CreateNewEntryStream(ctx)
+}
+
 func (m *CounterClient) RetrieveTopFive(ctx context.Context, in *pb.TopFiveRequest) (*pb.TopFiveResponse, error) {
 	return m.counter.RetrieveTopFive(ctx, in)
 }
@@ -84,3 +89,27 @@ func (m *CounterClient) RetrieveTopFiveService(ctx context.Context, in *pb.TopFi
 func (m *CounterClient) RetrieveTopFiveForSession(ctx context.Context, in *pb.TopFiveSessionRequest) (*pb.TopFiveResponse, error) {
 	return m.counter.RetrieveTopFiveForSession(ctx, in)
 }
+
+func (m *CounterClient) RetrieveTopFiveWindow(ctx context.Context, in *pb.TopFiveWindowRequest) (*pb.TopFiveResponse, error) {
+	return m.counter.RetrieveTopFiveWindow(ctx, in)
+}
+
+func (m *CounterClient) RetrieveTop(ctx context.Context, in *pb.TopRequest) (*pb.TopResponse, error) {
+	return m.counter.RetrieveTop(ctx, in)
+}
+
+func (m *CounterClient) RetrieveZeroResults(ctx context.Context, in *pb.ZeroResultsRequest) (*pb.TopResponse, error) {
+	return m.counter.RetrieveZeroResults(ctx, in)
+}
+
+func (m *CounterClient) RetrieveTrending(ctx context.Context, in *pb.TrendingRequest) (*pb.TrendingResponse, error) {
+	return m.counter.RetrieveTrending(ctx, in)
+}
+
+func (m *CounterClient) ExportCounters(ctx context.Context, in *pb.ExportRequest) (pb.Eukleides_ExportCountersClient, error) {
+	return m.counter.ExportCounters(ctx, in)
+}
+
+func (m *CounterClient) ForgetSession(ctx context.Context, in *pb.ForgetSessionRequest) (*pb.ForgetSessionResponse, error) {
+	return m.counter.ForgetSession(ctx, in)
+}
@@ -0,0 +1,453 @@
+package geometrias
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/odysseia-greek/agora/plato/logging"
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// EntryCreator opens CreateNewEntryStream streams; CounterClient satisfies it.
+type EntryCreator interface {
+	CreateNewEntryStream(ctx context.Context) (pb.Eukleides_CreateNewEntryStreamClient, error)
+}
+
+// errStreamEnded is returned while waiting for an ack on a stream eukleides closed without an error.
+var errStreamEnded = errors.New("eukleides ended the stream")
+
+// errSpillFull is returned by spill once the spill file reached MaxSpillBytes.
+var errSpillFull = errors.New("spill file is full")
+
+// IngestConfig tunes how an Ingester batches and buffers events.
+type IngestConfig struct {
+	// BatchSize is the number of events sent as one CountCreationRequestSet.
+	BatchSize int
+	// FlushInterval is the longest an event waits for its batch to fill up.
+	FlushInterval time.Duration
+	// QueueSize is the number of events buffered while a batch is being sent or the stream is down.
+	QueueSize int
+	// SpillPath is an optional file that takes the events that do not fit the queue; they are dropped when empty.
+	SpillPath string
+	// MaxSpillBytes caps the spill file, events that would grow it past the cap are dropped.
+	MaxSpillBytes int64
+	// MinBackoff and MaxBackoff bound the wait between attempts to re-establish the stream.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// AckTimeout is how long a batch may wait for its ack before the stream is considered broken.
+	AckTimeout time.Duration
+}
+
+func DefaultIngestConfig() IngestConfig {
+	return IngestConfig{
+		BatchSize:     50,
+		FlushInterval: 2 * time.Second,
+		QueueSize:     10000,
+		MaxSpillBytes: 64 << 20,
+		MinBackoff:    500 * time.Millisecond,
+		MaxBackoff:    30 * time.Second,
+		AckTimeout:    10 * time.Second,
+	}
+}
+
+// IngestStats are cumulative counters of an Ingester since it started.
+type IngestStats struct {
+	// Sent counts the events eukleides acked as applied
+	Sent       int64
+	Dropped    int64
+	Spilled    int64
+	Reconnects int64
+	Queued     int
+}
+
+// Ingester batches count events and sends them over a CreateNewEntryStream stream, waiting for the ack of every
+// batch and re-opening the stream with backoff whenever it breaks. Push never blocks: once the queue is full events
+// are spilled to disk or dropped. Delivery is at least once; a batch that was applied but whose ack got lost is sent
+// again.
+type Ingester struct {
+	client EntryCreator
+	cfg    IngestConfig
+	events chan *pb.CountCreationRequest
+
+	stream       pb.Eukleides_CreateNewEntryStreamClient
+	cancelStream context.CancelFunc
+	batches      int64
+	backoff      time.Duration
+
+	spillMu sync.Mutex
+	// spillPending is set while the spill file may hold events that still have to be replayed
+	spillPending atomic.Bool
+
+	sent       atomic.Int64
+	dropped    atomic.Int64
+	spilled    atomic.Int64
+	reconnects atomic.Int64
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewIngester(client EntryCreator, cfg IngestConfig) *Ingester {
+	defaults := DefaultIngestConfig()
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaults.FlushInterval
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaults.QueueSize
+	}
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = defaults.MinBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = defaults.MaxBackoff
+	}
+	if cfg.AckTimeout <= 0 {
+		cfg.AckTimeout = defaults.AckTimeout
+	}
+	if cfg.MaxSpillBytes <= 0 {
+		cfg.MaxSpillBytes = defaults.MaxSpillBytes
+	}
+
+	ingester := &Ingester{
+		client:  client,
+		cfg:     cfg,
+		events:  make(chan *pb.CountCreationRequest, cfg.QueueSize),
+		backoff: cfg.MinBackoff,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	// a spill file left by an earlier run is replayed as well
+	ingester.spillPending.Store(cfg.SpillPath != "")
+	return ingester
+}
+
+// Start runs the send loop until ctx is done or Close is called.
+func (i *Ingester) Start(ctx context.Context) {
+	go i.run(ctx)
+}
+
+// Push queues an event without blocking and reports whether it was queued or spilled.
+func (i *Ingester) Push(event *pb.CountCreationRequest) bool {
+	select {
+	case i.events <- event:
+		return true
+	default:
+		return i.overflow([]*pb.CountCreationRequest{event})
+	}
+}
+
+// Close sends whatever is still queued with a single attempt, closes the stream and waits for the loop to end.
+func (i *Ingester) Close() {
+	i.stopOnce.Do(func() { close(i.stop) })
+	<-i.done
+}
+
+func (i *Ingester) Stats() IngestStats {
+	return IngestStats{
+		Sent:       i.sent.Load(),
+		Dropped:    i.dropped.Load(),
+		Spilled:    i.spilled.Load(),
+		Reconnects: i.reconnects.Load(),
+		Queued:     len(i.events),
+	}
+}
+
+func (i *Ingester) run(ctx context.Context) {
+	defer close(i.done)
+
+	ticker := time.NewTicker(i.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*pb.CountCreationRequest, 0, i.cfg.BatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			i.shutdown(ctx, batch)
+			return
+		case <-i.stop:
+			i.shutdown(ctx, batch)
+			return
+		case event := <-i.events:
+			batch = append(batch, event)
+			if len(batch) < i.cfg.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				i.replaySpill(ctx)
+				continue
+			}
+		}
+
+		if !i.sendWithRetry(ctx, batch) {
+			i.overflow(batch)
+			batch = batch[:0]
+			continue
+		}
+		batch = batch[:0]
+
+		// the stream just took a batch, so it is healthy enough to take the spilled events as well, however busy
+		// the queue is
+		i.replaySpill(ctx)
+	}
+}
+
+// sendWithRetry keeps trying to deliver batch, backing off between attempts, until it succeeds or the ingester stops.
+// While it waits the queue fills up and Push starts spilling or dropping, which is the back-pressure we want.
+func (i *Ingester) sendWithRetry(ctx context.Context, batch []*pb.CountCreationRequest) bool {
+	for {
+		err := i.send(ctx, batch)
+		if err == nil {
+			i.backoff = i.cfg.MinBackoff
+			return true
+		}
+
+		logging.Error(fmt.Sprintf("failed to send %d events to eukleides, retrying in %s: %s", len(batch), i.backoff, err.Error()))
+
+		timer := time.NewTimer(i.backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-i.stop:
+			timer.Stop()
+			return false
+		}
+
+		i.backoff *= 2
+		if i.backoff > i.cfg.MaxBackoff {
+			i.backoff = i.cfg.MaxBackoff
+		}
+	}
+}
+
+func (i *Ingester) send(ctx context.Context, batch []*pb.CountCreationRequest) error {
+	if i.stream == nil {
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream, err := i.client.CreateNewEntryStream(streamCtx)
+		if err != nil {
+			cancel()
+			return err
+		}
+		i.stream, i.cancelStream = stream, cancel
+		i.reconnects.Add(1)
+	}
+
+	i.batches++
+	batchID := strconv.FormatInt(i.batches, 10)
+	err := i.stream.Send(&pb.CountCreationRequestSet{Request: batch, BatchId: batchID})
+	if err != nil && !errors.Is(err, io.EOF) {
+		i.resetStream()
+		return err
+	}
+
+	// after io.EOF from Send the cause of the broken stream comes from Recv
+	ack, err := i.awaitAck()
+	if err != nil {
+		i.resetStream()
+		return err
+	}
+	if ack.BatchId != batchID {
+		i.resetStream()
+		return fmt.Errorf("eukleides acked batch %s while batch %s was waiting", ack.BatchId, batchID)
+	}
+
+	i.sent.Add(ack.Applied)
+	return nil
+}
+
+// awaitAck waits for the next ack on the open stream for at most AckTimeout.
+func (i *Ingester) awaitAck() (*pb.CountBatchAck, error) {
+	type received struct {
+		ack *pb.CountBatchAck
+		err error
+	}
+
+	stream := i.stream
+	acks := make(chan received, 1)
+	go func() {
+		ack, err := stream.Recv()
+		acks <- received{ack: ack, err: err}
+	}()
+
+	timer := time.NewTimer(i.cfg.AckTimeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-acks:
+		if errors.Is(r.err, io.EOF) {
+			return nil, errStreamEnded
+		}
+		return r.ack, r.err
+	case <-timer.C:
+		// resetting the stream cancels its context, which ends the Recv above
+		return nil, fmt.Errorf("no ack from eukleides within %s", i.cfg.AckTimeout)
+	}
+}
+
+// closeStream tells eukleides no more batches follow and waits for it to end the stream, every batch was acked
+// already.
+func (i *Ingester) closeStream() {
+	if i.stream == nil {
+		return
+	}
+
+	if err := i.stream.CloseSend(); err == nil {
+		if _, err := i.awaitAck(); err != nil && !errors.Is(err, errStreamEnded) {
+			logging.Error(fmt.Sprintf("failed to close eukleides stream: %s", err.Error()))
+		}
+	}
+	i.resetStream()
+}
+
+func (i *Ingester) resetStream() {
+	if i.cancelStream != nil {
+		i.cancelStream()
+	}
+	i.stream, i.cancelStream = nil, nil
+}
+
+func (i *Ingester) shutdown(ctx context.Context, batch []*pb.CountCreationRequest) {
+drain:
+	for {
+		select {
+		case event := <-i.events:
+			batch = append(batch, event)
+		default:
+			break drain
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := i.send(ctx, batch); err != nil {
+			logging.Error(fmt.Sprintf("failed to send %d events to eukleides on shutdown: %s", len(batch), err.Error()))
+			i.overflow(batch)
+		}
+	}
+
+	i.closeStream()
+
+	stats := i.Stats()
+	logging.System(fmt.Sprintf("eukleides ingestion stopped - sent: %d, dropped: %d, spilled: %d, reconnects: %d",
+		stats.Sent, stats.Dropped, stats.Spilled, stats.Reconnects))
+}
+
+// overflow spills events to disk when a spill file is configured and drops them otherwise.
+func (i *Ingester) overflow(events []*pb.CountCreationRequest) bool {
+	if i.cfg.SpillPath == "" {
+		i.dropped.Add(int64(len(events)))
+		return false
+	}
+
+	if err := i.spill(events); err != nil {
+		logging.Error(fmt.Sprintf("failed to spill %d events to %s: %s", len(events), i.cfg.SpillPath, err.Error()))
+		i.dropped.Add(int64(len(events)))
+		return false
+	}
+
+	i.spilled.Add(int64(len(events)))
+	return true
+}
+
+// spill appends events as protojson lines to the spill file, as long as they fit under MaxSpillBytes.
+func (i *Ingester) spill(events []*pb.CountCreationRequest) error {
+	var lines bytes.Buffer
+	for _, event := range events {
+		line, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+		lines.Write(line)
+		lines.WriteByte('\n')
+	}
+
+	i.spillMu.Lock()
+	defer i.spillMu.Unlock()
+
+	var size int64
+	if info, err := os.Stat(i.cfg.SpillPath); err == nil {
+		size = info.Size()
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if size+int64(lines.Len()) > i.cfg.MaxSpillBytes {
+		return fmt.Errorf("%w: %d of %d bytes used", errSpillFull, size, i.cfg.MaxSpillBytes)
+	}
+
+	f, err := os.OpenFile(i.cfg.SpillPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(lines.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	i.spillPending.Store(true)
+
+	return f.Close()
+}
+
+// replaySpill sends spilled events back to eukleides, after a batch went through or on an idle tick. Events that
+// cannot be sent are written back to the spill file for the next attempt.
+func (i *Ingester) replaySpill(ctx context.Context) {
+	if !i.spillPending.Load() {
+		return
+	}
+
+	i.spillMu.Lock()
+	raw, err := os.ReadFile(i.cfg.SpillPath)
+	if err == nil {
+		err = os.Remove(i.cfg.SpillPath)
+	}
+	if err == nil || errors.Is(err, os.ErrNotExist) {
+		i.spillPending.Store(false)
+	}
+	i.spillMu.Unlock()
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logging.Error(fmt.Sprintf("failed to read spill file %s: %s", i.cfg.SpillPath, err.Error()))
+		}
+		return
+	}
+
+	var events []*pb.CountCreationRequest
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		var event pb.CountCreationRequest
+		if err := protojson.Unmarshal(scanner.Bytes(), &event); err != nil {
+			i.dropped.Add(1)
+			continue
+		}
+		events = append(events, &event)
+	}
+
+	for start := 0; start < len(events); start += i.cfg.BatchSize {
+		end := min(start+i.cfg.BatchSize, len(events))
+		if err := i.send(ctx, events[start:end]); err != nil {
+			logging.Error(fmt.Sprintf("failed to replay spilled events: %s", err.Error()))
+			if err := i.spill(events[start:]); err != nil {
+				i.dropped.Add(int64(len(events) - start))
+			}
+			return
+		}
+	}
+
+	if len(events) > 0 {
+		logging.Info(fmt.Sprintf("replayed %d spilled events to eukleides", len(events)))
+	}
+}
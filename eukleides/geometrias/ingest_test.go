@@ -0,0 +1,242 @@
+package geometrias
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type fakeEntryStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	creator *fakeEntryCreator
+	broken  bool
+	silent  bool
+	acks    chan *pb.CountBatchAck
+}
+
+func (f *fakeEntryStream) Send(set *pb.CountCreationRequestSet) error {
+	if f.broken {
+		return io.EOF
+	}
+
+	f.creator.mu.Lock()
+	defer f.creator.mu.Unlock()
+	f.creator.sets = append(f.creator.sets, set)
+	if !f.silent {
+		f.acks <- &pb.CountBatchAck{BatchId: set.BatchId, Applied: int64(len(set.Request))}
+	}
+	return nil
+}
+
+func (f *fakeEntryStream) Recv() (*pb.CountBatchAck, error) {
+	if f.broken {
+		return nil, errors.New("connection reset")
+	}
+
+	select {
+	case ack, ok := <-f.acks:
+		if !ok {
+			return nil, io.EOF
+		}
+		return ack, nil
+	case <-f.ctx.Done():
+		return nil, f.ctx.Err()
+	}
+}
+
+func (f *fakeEntryStream) CloseSend() error {
+	f.creator.mu.Lock()
+	defer f.creator.mu.Unlock()
+	f.creator.closed++
+	close(f.acks)
+	return nil
+}
+
+// fakeEntryCreator hands out streams that record and ack every set sent; the first broken streams fail on Send and
+// the silent ones after those never ack.
+type fakeEntryCreator struct {
+	mu     sync.Mutex
+	sets   []*pb.CountCreationRequestSet
+	opened int
+	closed int
+	broken int
+	silent int
+}
+
+func (f *fakeEntryCreator) CreateNewEntryStream(ctx context.Context) (pb.Eukleides_CreateNewEntryStreamClient, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.opened++
+	return &fakeEntryStream{
+		ctx:     ctx,
+		creator: f,
+		broken:  f.opened <= f.broken,
+		silent:  f.opened > f.broken && f.opened <= f.broken+f.silent,
+		acks:    make(chan *pb.CountBatchAck, 16),
+	}, nil
+}
+
+func (f *fakeEntryCreator) received() (sets, events int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, set := range f.sets {
+		events += len(set.Request)
+	}
+	return len(f.sets), events
+}
+
+func countEvent(word string) *pb.CountCreationRequest {
+	return &pb.CountCreationRequest{Word: word, ServiceName: "exact", SearchType: "exact", SessionId: "s1"}
+}
+
+func TestIngester(t *testing.T) {
+	t.Run("BatchesBySize", func(t *testing.T) {
+		creator := &fakeEntryCreator{}
+		sut := NewIngester(creator, IngestConfig{BatchSize: 3, FlushInterval: time.Hour})
+		sut.Start(context.Background())
+
+		for i := 0; i < 6; i++ {
+			assert.True(t, sut.Push(countEvent("λόγος")))
+		}
+
+		assert.Eventually(t, func() bool {
+			sets, events := creator.received()
+			return sets == 2 && events == 6
+		}, time.Second, 10*time.Millisecond)
+
+		sut.Close()
+		assert.Equal(t, int64(6), sut.Stats().Sent)
+		// the stream is closed on shutdown, eukleides does not have to cut it off
+		assert.Equal(t, 1, creator.closed)
+	})
+
+	t.Run("ResendsUnackedBatches", func(t *testing.T) {
+		creator := &fakeEntryCreator{silent: 1}
+		sut := NewIngester(creator, IngestConfig{BatchSize: 1, FlushInterval: time.Hour, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, AckTimeout: 20 * time.Millisecond})
+		sut.Start(context.Background())
+		defer sut.Close()
+
+		sut.Push(countEvent("λόγος"))
+
+		assert.Eventually(t, func() bool {
+			return sut.Stats().Sent == 1
+		}, time.Second, 10*time.Millisecond)
+		sets, _ := creator.received()
+		assert.Equal(t, 2, sets)
+		assert.Equal(t, int64(2), sut.Stats().Reconnects)
+	})
+
+	t.Run("FlushesOnInterval", func(t *testing.T) {
+		creator := &fakeEntryCreator{}
+		sut := NewIngester(creator, IngestConfig{BatchSize: 100, FlushInterval: 10 * time.Millisecond})
+		sut.Start(context.Background())
+		defer sut.Close()
+
+		sut.Push(countEvent("λόγος"))
+
+		assert.Eventually(t, func() bool {
+			_, events := creator.received()
+			return events == 1
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("ReconnectsAfterBrokenStream", func(t *testing.T) {
+		creator := &fakeEntryCreator{broken: 2}
+		sut := NewIngester(creator, IngestConfig{BatchSize: 1, FlushInterval: time.Hour, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})
+		sut.Start(context.Background())
+		defer sut.Close()
+
+		sut.Push(countEvent("λόγος"))
+
+		assert.Eventually(t, func() bool {
+			return sut.Stats().Sent == 1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, int64(3), sut.Stats().Reconnects)
+	})
+
+	t.Run("DropsWhenQueueIsFull", func(t *testing.T) {
+		sut := NewIngester(&fakeEntryCreator{}, IngestConfig{QueueSize: 2})
+
+		assert.True(t, sut.Push(countEvent("λόγος")))
+		assert.True(t, sut.Push(countEvent("λόγος")))
+		assert.False(t, sut.Push(countEvent("λόγος")))
+		assert.Equal(t, int64(1), sut.Stats().Dropped)
+	})
+
+	t.Run("SpillsAndReplays", func(t *testing.T) {
+		creator := &fakeEntryCreator{}
+		path := filepath.Join(t.TempDir(), "spill.ndjson")
+		sut := NewIngester(creator, IngestConfig{BatchSize: 10, FlushInterval: 10 * time.Millisecond, QueueSize: 1, SpillPath: path})
+
+		for i := 0; i < 3; i++ {
+			assert.True(t, sut.Push(countEvent("λόγος")))
+		}
+		assert.Equal(t, int64(2), sut.Stats().Spilled)
+
+		sut.Start(context.Background())
+		defer sut.Close()
+
+		assert.Eventually(t, func() bool {
+			_, events := creator.received()
+			return events == 3
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, int64(0), sut.Stats().Dropped)
+	})
+
+	t.Run("ReplaysUnderSteadyTraffic", func(t *testing.T) {
+		creator := &fakeEntryCreator{}
+		path := filepath.Join(t.TempDir(), "spill.ndjson")
+		// the ticker never fires, every batch is full the moment it is sent
+		sut := NewIngester(creator, IngestConfig{BatchSize: 1, FlushInterval: time.Hour, QueueSize: 1, SpillPath: path})
+
+		assert.True(t, sut.Push(countEvent("λόγος")))
+		assert.True(t, sut.Push(countEvent("θεός")))
+		assert.Equal(t, int64(1), sut.Stats().Spilled)
+
+		sut.Start(context.Background())
+		defer sut.Close()
+
+		received := func(word string) bool {
+			creator.mu.Lock()
+			defer creator.mu.Unlock()
+			for _, set := range creator.sets {
+				for _, request := range set.Request {
+					if request.Word == word {
+						return true
+					}
+				}
+			}
+			return false
+		}
+		assert.Eventually(t, func() bool {
+			sut.Push(countEvent("πόλις"))
+			return received("θεός")
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("SpillIsCapped", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "spill.ndjson")
+		sut := NewIngester(&fakeEntryCreator{}, IngestConfig{QueueSize: 1, SpillPath: path, MaxSpillBytes: 256})
+
+		for i := 0; i < 20; i++ {
+			sut.Push(countEvent("λόγος"))
+		}
+
+		info, err := os.Stat(path)
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(256))
+		stats := sut.Stats()
+		assert.Greater(t, stats.Dropped, int64(0))
+		assert.Equal(t, int64(19), stats.Spilled+stats.Dropped)
+	})
+}
@@ -0,0 +1,136 @@
+package geometrias
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+)
+
+const (
+	// SessionPlain stores session ids as they arrive in the session header.
+	SessionPlain string = "plain"
+	// SessionSHA256 stores the hex sha256 of the session id.
+	SessionSHA256 string = "sha256"
+	// SessionHMAC stores the hex HMAC-SHA256 of the session id under a secret key, so ids cannot be recovered by
+	// hashing guesses without the key.
+	SessionHMAC string = "hmac"
+)
+
+// NewSessionHasher returns the function that turns an incoming session id into the one that is stored.
+// Lookups by session go through the same function, so callers keep using the id from their header.
+func NewSessionHasher(mode, key string) (func(string) string, error) {
+	switch mode {
+	case "", SessionPlain:
+		return nil, nil
+	case SessionSHA256:
+		return func(id string) string {
+			sum := sha256.Sum256([]byte(id))
+			return hex.EncodeToString(sum[:])
+		}, nil
+	case SessionHMAC:
+		if key == "" {
+			return nil, fmt.Errorf("session mode %s needs a key", SessionHMAC)
+		}
+		return func(id string) string {
+			mac := hmac.New(sha256.New, []byte(key))
+			mac.Write([]byte(id))
+			return hex.EncodeToString(mac.Sum(nil))
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported session mode: %s", mode)
+	}
+}
+
+// PseudonymiseSessions makes the store key session counters by hasher(id) instead of the id itself. Counters
+// restored from a snapshot taken in plain mode keep their raw keys until they are evicted, ForgetSession removes
+// those as well.
+func (s *Store) PseudonymiseSessions(hasher func(string) string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hashSession = hasher
+}
+
+// sessionID maps an incoming session id onto the stored one. Callers hold s.mu.
+func (s *Store) sessionID(id string) string {
+	if s.hashSession == nil || id == "" {
+		return id
+	}
+	return s.hashSession(id)
+}
+
+// ForgetSession deletes every counter of a session, under its stored id and under the raw id it may still have from
+// a plain snapshot, and returns how many were removed. Global aggregates are kept, they do not point back to a session.
+func (s *Store) ForgetSession(id string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session := s.sessionID(id)
+	removed := 0
+	for k := range s.session {
+		if k.Session == session || k.Session == id {
+			s.removeSession(k)
+			removed++
+		}
+	}
+
+	s.sessions.forgotten.Add(int64(removed))
+	return removed
+}
+
+// RetainSessions drops session counters once they are older than retention, counted from their first use.
+// Zero keeps them until they go idle or are pushed out by the cap.
+func (s *Store) RetainSessions(retention time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions.retention = retention
+}
+
+// ExpireSessions drops every session counter past the retention period and returns how many went.
+func (s *Store) ExpireSessions(now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sessions.retention <= 0 {
+		return 0
+	}
+
+	cutoff := now.Add(-s.sessions.retention)
+	expired := 0
+	for k, c := range s.session {
+		firstUsed := c.FirstUsed
+		if firstUsed.IsZero() {
+			// restored from a snapshot that predates FirstUsed
+			firstUsed = c.LastUsed
+		}
+		if firstUsed.Before(cutoff) {
+			s.removeSession(k)
+			expired++
+		}
+	}
+
+	s.sessions.expired.Add(int64(expired))
+	return expired
+}
+
+// ForgetSession erases all counters of a session and persists the erasure right away.
+func (c *CounterServiceImpl) ForgetSession(ctx context.Context, in *pb.ForgetSessionRequest) (*pb.ForgetSessionResponse, error) {
+	if in.SessionId == "" {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidArgument, errors.New("session_id is required"))
+	}
+
+	removed := c.store.ForgetSession(in.SessionId)
+	if err := c.store.Flush(); err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonStorage, fmt.Errorf("session forgotten in memory but not yet in storage: %w", err))
+	}
+
+	return &pb.ForgetSessionResponse{Removed: int64(removed)}, nil
+}
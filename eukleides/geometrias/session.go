@@ -0,0 +1,153 @@
+package geometrias
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/odysseia-greek/agora/plato/logging"
+)
+
+// sessionLimiter bounds the per-session counters. Session keys are kept in a recency list (front is most recently
+// used) so both the idle check and the capacity check only ever look at the back of the list.
+// All fields except the eviction counters are guarded by Store.mu.
+type sessionLimiter struct {
+	idleTTL   time.Duration
+	maxKeys   int
+	retention time.Duration
+	recency   *list.List
+	elems     map[SessKey]*list.Element
+
+	evictedIdle     atomic.Int64
+	evictedCapacity atomic.Int64
+	expired         atomic.Int64
+	forgotten       atomic.Int64
+}
+
+// SessionStats reports the current number of session counters and how many were removed since start.
+type SessionStats struct {
+	Active          int
+	EvictedIdle     int64
+	EvictedCapacity int64
+	Expired         int64
+	Forgotten       int64
+}
+
+func (l *sessionLimiter) touch(key SessKey) {
+	if e, ok := l.elems[key]; ok {
+		l.recency.MoveToFront(e)
+		return
+	}
+	l.elems[key] = l.recency.PushFront(key)
+}
+
+func (l *sessionLimiter) oldest() (SessKey, bool) {
+	e := l.recency.Back()
+	if e == nil {
+		return SessKey{}, false
+	}
+	return e.Value.(SessKey), true
+}
+
+// LimitSessions sets the idle timeout and the maximum number of session keys; zero disables either limit.
+// Keys over the new capacity are evicted right away.
+func (s *Store) LimitSessions(idleTTL time.Duration, maxKeys int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions.idleTTL = idleTTL
+	s.sessions.maxKeys = maxKeys
+	s.evictOverCapacity()
+}
+
+// evictOverCapacity drops least recently used session keys until the cap is met. Callers hold s.mu.
+func (s *Store) evictOverCapacity() {
+	if s.sessions.maxKeys <= 0 {
+		return
+	}
+	for len(s.session) > s.sessions.maxKeys {
+		key, ok := s.sessions.oldest()
+		if !ok {
+			return
+		}
+		s.removeSession(key)
+		s.sessions.evictedCapacity.Add(1)
+	}
+}
+
+// EvictIdleSessions drops every session key that was not used within the idle timeout and returns how many went.
+func (s *Store) EvictIdleSessions(now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sessions.idleTTL <= 0 {
+		return 0
+	}
+
+	cutoff := now.Add(-s.sessions.idleTTL)
+	evicted := 0
+	for {
+		key, ok := s.sessions.oldest()
+		if !ok {
+			break
+		}
+		// keys are touched as they are counted, so the first one still in use ends the scan
+		if c := s.session[key]; c != nil && c.LastUsed.After(cutoff) {
+			break
+		}
+		s.removeSession(key)
+		evicted++
+	}
+
+	s.sessions.evictedIdle.Add(int64(evicted))
+	return evicted
+}
+
+func (s *Store) removeSession(key SessKey) {
+	if e, ok := s.sessions.elems[key]; ok {
+		s.sessions.recency.Remove(e)
+		delete(s.sessions.elems, key)
+	}
+	delete(s.session, key)
+}
+
+func (s *Store) SessionStats() SessionStats {
+	s.mu.RLock()
+	active := len(s.session)
+	s.mu.RUnlock()
+
+	return SessionStats{
+		Active:          active,
+		EvictedIdle:     s.sessions.evictedIdle.Load(),
+		EvictedCapacity: s.sessions.evictedCapacity.Load(),
+		Expired:         s.sessions.expired.Load(),
+		Forgotten:       s.sessions.forgotten.Load(),
+	}
+}
+
+// StartJanitor evicts idle and expired sessions every interval until ctx is done and reports what it removed.
+func (s *Store) StartJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				now := time.Now().UTC()
+				evicted := s.EvictIdleSessions(now)
+				expired := s.ExpireSessions(now)
+				if evicted == 0 && expired == 0 {
+					continue
+				}
+
+				stats := s.SessionStats()
+				logging.Info(fmt.Sprintf("Session Stats - Evicted Idle: %d (total %d), Expired: %d (total %d), Evicted Capacity: %d, Active: %d",
+					evicted, stats.EvictedIdle, expired, stats.Expired, stats.EvictedCapacity, stats.Active))
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
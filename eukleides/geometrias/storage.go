@@ -0,0 +1,141 @@
+package geometrias
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/odysseia-greek/agora/archytas"
+)
+
+const (
+	StorageMemory string = "memory"
+	StorageBadger string = "badger"
+
+	snapshotKey string = "eukleides:snapshot"
+)
+
+// Storage persists snapshots of a Store so counters survive a restart.
+type Storage interface {
+	Load() (*Snapshot, error)
+	Save(snapshot *Snapshot) error
+	Close() error
+}
+
+// Snapshot is the serialised form of all counters held by a Store.
+type Snapshot struct {
+	Global  []GlobalEntry  `json:"global"`
+	Session []SessionEntry `json:"session"`
+	Hourly  []BucketEntry  `json:"hourly"`
+	Daily   []BucketEntry  `json:"daily"`
+}
+
+type GlobalEntry struct {
+	GlobalKey
+	Counter
+}
+
+type SessionEntry struct {
+	SessKey
+	Counter
+}
+
+// NewStorage returns the Storage implementation for backend. An empty backend falls back to memory.
+func NewStorage(backend, path string) (Storage, error) {
+	switch backend {
+	case "", StorageMemory:
+		return NewMemoryStorage(), nil
+	case StorageBadger:
+		return NewBadgerStorage(path)
+	default:
+		return nil, fmt.Errorf("unsupported storage backend: %s", backend)
+	}
+}
+
+// MemoryStorage keeps the last saved snapshot in memory. It is the default and is meant for tests and local runs.
+type MemoryStorage struct {
+	mu       sync.Mutex
+	snapshot []byte
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{}
+}
+
+func (m *MemoryStorage) Load() (*Snapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.snapshot == nil {
+		return nil, nil
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(m.snapshot, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+func (m *MemoryStorage) Save(snapshot *Snapshot) error {
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.snapshot = b
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *MemoryStorage) Close() error {
+	return nil
+}
+
+// BadgerStorage writes snapshots to an embedded Badger database on disk.
+type BadgerStorage struct {
+	cache archytas.Client
+}
+
+func NewBadgerStorage(path string) (*BadgerStorage, error) {
+	cache, err := archytas.NewBadgerClient(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open badger storage at %s: %w", path, err)
+	}
+
+	return &BadgerStorage{cache: cache}, nil
+}
+
+func (b *BadgerStorage) Load() (*Snapshot, error) {
+	raw, err := b.cache.Read(snapshotKey)
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+
+	return &snapshot, nil
+}
+
+func (b *BadgerStorage) Save(snapshot *Snapshot) error {
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return b.cache.Set(snapshotKey, string(raw))
+}
+
+func (b *BadgerStorage) Close() error {
+	return b.cache.Close()
+}
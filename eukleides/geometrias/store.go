@@ -1,10 +1,18 @@
 package geometrias
 
 import (
+	"container/list"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/odysseia-greek/agora/plato/logging"
+
 	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
 )
 
@@ -12,49 +20,234 @@ type GlobalKey struct{ Service, Word string }
 type SessKey struct{ Session, Service, Word string }
 
 type Counter struct {
-	Count    int64
-	LastUsed time.Time
+	Count     int64
+	FirstUsed time.Time
+	LastUsed  time.Time
+	// SearchTypes splits Count by the search type (exact, fuzzy, ...) the word was searched with.
+	SearchTypes map[string]int64 `json:",omitempty"`
+	// Languages splits Count by the koinos.v1.Language name of the search.
+	Languages map[string]int64 `json:",omitempty"`
+	// Hits sums the results of every search that reported a result count; ZeroResults counts those that found nothing.
+	Hits        int64 `json:",omitempty"`
+	ZeroResults int64 `json:",omitempty"`
+}
+
+// Event is a single search as reported by the gateway.
+type Event struct {
+	SessionID  string
+	Service    string
+	SearchType string
+	Language   string
+	Word       string
+	// ResultCount is nil when the gateway could not tell how many results the search returned.
+	ResultCount *int64
+	At          time.Time
+}
+
+func (c *Counter) inc(e Event) {
+	c.Count++
+	if c.FirstUsed.IsZero() || e.At.Before(c.FirstUsed) {
+		c.FirstUsed = e.At
+	}
+	if e.At.After(c.LastUsed) {
+		c.LastUsed = e.At
+	}
+	if e.SearchType != "" {
+		if c.SearchTypes == nil {
+			c.SearchTypes = make(map[string]int64, 1)
+		}
+		c.SearchTypes[e.SearchType]++
+	}
+	if e.Language != "" {
+		if c.Languages == nil {
+			c.Languages = make(map[string]int64, 1)
+		}
+		c.Languages[e.Language]++
+	}
+	if e.ResultCount != nil {
+		c.Hits += *e.ResultCount
+		if *e.ResultCount == 0 {
+			c.ZeroResults++
+		}
+	}
+}
+
+// clone copies c including its maps, so the copy can be read without holding the store lock.
+func (c *Counter) clone() Counter {
+	out := *c
+	out.SearchTypes = maps.Clone(c.SearchTypes)
+	out.Languages = maps.Clone(c.Languages)
+	return out
 }
 
 type Store struct {
 	mu      sync.RWMutex
 	global  map[GlobalKey]*Counter
 	session map[SessKey]*Counter
+	hourly  *buckets
+	daily   *buckets
+	storage Storage
+	// sessions keeps the session keys in least recently used order, see session.go
+	sessions sessionLimiter
+	// hashSession pseudonymises session ids before they are stored, see privacy.go
+	hashSession func(string) string
+	// flushMu keeps snapshot and save of one flush together, so saves land in the order their snapshots were taken
+	// and an older snapshot never overwrites a newer one
+	flushMu     sync.Mutex
+	flushFailed atomic.Bool
 }
 
+// NewStore returns an empty Store backed by MemoryStorage.
 func NewStore() *Store {
 	return &Store{
 		global:  make(map[GlobalKey]*Counter, 1024),
 		session: make(map[SessKey]*Counter, 1024),
+		hourly:  newBuckets(time.Hour, hourlyRetention),
+		daily:   newBuckets(24*time.Hour, dailyRetention),
+		storage: NewMemoryStorage(),
+		sessions: sessionLimiter{
+			recency: list.New(),
+			elems:   make(map[SessKey]*list.Element, 1024),
+		},
 	}
 }
 
-// Inc increments both global and per-session counters in a single critical section.
-func (s *Store) Inc(sessionID, service, word string, ts time.Time) {
+// NewStoreWithStorage returns a Store backed by storage and restored from the last snapshot it holds.
+func NewStoreWithStorage(storage Storage) (*Store, error) {
+	s := NewStore()
+	s.storage = storage
+
+	snapshot, err := storage.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore counters: %w", err)
+	}
+
+	if snapshot != nil {
+		s.restore(snapshot)
+		logging.Info(fmt.Sprintf("restored %d global and %d session counters", len(snapshot.Global), len(snapshot.Session)))
+	}
+
+	return s, nil
+}
+
+func (s *Store) restore(snapshot *Snapshot) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// global
-	gk := GlobalKey{Service: service, Word: word}
-	if c := s.global[gk]; c != nil {
-		c.Count++
-		if ts.After(c.LastUsed) {
-			c.LastUsed = ts
+	for _, e := range snapshot.Global {
+		c := e.Counter
+		s.global[e.GlobalKey] = &c
+	}
+	// oldest first, so the most recently used session ends up at the front of the recency list
+	sessions := slices.Clone(snapshot.Session)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].LastUsed.Before(sessions[j].LastUsed)
+	})
+	for _, e := range sessions {
+		c := e.Counter
+		s.session[e.SessKey] = &c
+		s.sessions.touch(e.SessKey)
+	}
+	s.evictOverCapacity()
+
+	now := time.Now()
+	s.hourly.restore(snapshot.Hourly)
+	s.hourly.prune(now)
+	s.daily.restore(snapshot.Daily)
+	s.daily.prune(now)
+}
+
+// Snapshot copies all counters so they can be persisted without holding the lock.
+func (s *Store) Snapshot() *Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	snapshot := &Snapshot{
+		Global:  make([]GlobalEntry, 0, len(s.global)),
+		Session: make([]SessionEntry, 0, len(s.session)),
+	}
+	for k, v := range s.global {
+		snapshot.Global = append(snapshot.Global, GlobalEntry{GlobalKey: k, Counter: v.clone()})
+	}
+	for k, v := range s.session {
+		snapshot.Session = append(snapshot.Session, SessionEntry{SessKey: k, Counter: v.clone()})
+	}
+	snapshot.Hourly = s.hourly.entries()
+	snapshot.Daily = s.daily.entries()
+
+	return snapshot
+}
+
+// Flush writes the current counters to the underlying storage.
+func (s *Store) Flush() error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	err := s.storage.Save(s.Snapshot())
+	s.flushFailed.Store(err != nil)
+	return err
+}
+
+// Healthy is false while the last flush failed, the counters since then only live in memory.
+func (s *Store) Healthy() bool {
+	return !s.flushFailed.Load()
+}
+
+// StartFlushing flushes the store every interval until ctx is done.
+func (s *Store) StartFlushing(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.Flush(); err != nil {
+					logging.Error(fmt.Sprintf("failed to flush counters: %s", err.Error()))
+				}
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
 		}
-	} else {
-		s.global[gk] = &Counter{Count: 1, LastUsed: ts}
+	}()
+}
+
+// Close flushes the store one last time and releases the storage.
+func (s *Store) Close() error {
+	if err := s.Flush(); err != nil {
+		return err
+	}
+
+	return s.storage.Close()
+}
+
+// Inc increments the global, windowed and per-session counters in a single critical section.
+func (s *Store) Inc(e Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// global
+	gk := GlobalKey{Service: e.Service, Word: e.Word}
+	c := s.global[gk]
+	if c == nil {
+		c = &Counter{}
+		s.global[gk] = c
 	}
+	c.inc(e)
+
+	// windowed
+	s.hourly.inc(gk, e.At)
+	s.daily.inc(gk, e.At)
 
 	// per-session
-	sk := SessKey{Session: sessionID, Service: service, Word: word}
-	if c := s.session[sk]; c != nil {
-		c.Count++
-		if ts.After(c.LastUsed) {
-			c.LastUsed = ts
-		}
-	} else {
-		s.session[sk] = &Counter{Count: 1, LastUsed: ts}
+	sk := SessKey{Session: s.sessionID(e.SessionID), Service: e.Service, Word: e.Word}
+	sc := s.session[sk]
+	if sc == nil {
+		sc = &Counter{}
+		s.session[sk] = sc
 	}
+	sc.inc(e)
+	s.sessions.touch(sk)
+	s.evictOverCapacity()
 }
 
 type row struct {
@@ -64,58 +257,109 @@ type row struct {
 	lastUsed time.Time
 }
 
-func (s *Store) TopFiveGlobal() []*pb.TopFive {
-	s.mu.RLock()
-	out := make([]row, 0, len(s.global))
-	for k, v := range s.global {
-		out = append(out, row{service: k.Service, word: k.Word, count: v.Count, lastUsed: v.LastUsed})
-	}
-	s.mu.RUnlock()
-	return top5ToProto(out)
+const (
+	defaultTopLimit = 5
+	maxTopLimit     = 100
+)
+
+// TopQuery selects and pages counters for a ranking; empty filters match everything.
+type TopQuery struct {
+	Service    string
+	SearchType string
+	// Language keeps words that were searched at least once in this language.
+	Language string
+	// Session ranks the counters of a single session instead of the global ones.
+	Session string
+	// ZeroResults ranks words by how often their search returned nothing.
+	ZeroResults bool
+	Limit       int
+	Offset      int
 }
 
-// Top 5 within a service (global counters filtered by service)
-func (s *Store) TopFiveByService(service string) []*pb.TopFive {
-	s.mu.RLock()
-	out := make([]row, 0, 32)
-	for k, v := range s.global {
-		if k.Service == service {
-			out = append(out, row{service: k.Service, word: k.Word, count: v.Count, lastUsed: v.LastUsed})
+// Top ranks the counters matching q and returns the requested page together with the total number of matches.
+// With a SearchType filter a word is ranked by how often it was searched with that type only.
+func (s *Store) Top(q TopQuery) ([]*pb.TopFive, int) {
+	count := func(c *Counter) int64 {
+		if q.Language != "" && c.Languages[q.Language] == 0 {
+			return 0
+		}
+		switch {
+		case q.ZeroResults:
+			return c.ZeroResults
+		case q.SearchType != "":
+			return c.SearchTypes[q.SearchType]
+		default:
+			return c.Count
 		}
 	}
-	s.mu.RUnlock()
-	return top5ToProto(out)
-}
 
-func (s *Store) TopFiveForSession(session string) []*pb.TopFive {
 	s.mu.RLock()
 	out := make([]row, 0, 32)
-	for k, v := range s.session {
-		if k.Session == session {
-			out = append(out, row{service: k.Service, word: k.Word, count: v.Count, lastUsed: v.LastUsed})
+	if q.Session != "" {
+		session := s.sessionID(q.Session)
+		for k, v := range s.session {
+			if k.Session != session || (q.Service != "" && k.Service != q.Service) {
+				continue
+			}
+			if n := count(v); n > 0 {
+				out = append(out, row{service: k.Service, word: k.Word, count: n, lastUsed: v.LastUsed})
+			}
+		}
+	} else {
+		for k, v := range s.global {
+			if q.Service != "" && k.Service != q.Service {
+				continue
+			}
+			if n := count(v); n > 0 {
+				out = append(out, row{service: k.Service, word: k.Word, count: n, lastUsed: v.LastUsed})
+			}
 		}
 	}
 	s.mu.RUnlock()
-	return top5ToProto(out)
+
+	return rankToProto(out, q.Offset, q.Limit), len(out)
+}
+
+func (s *Store) TopFiveGlobal() []*pb.TopFive {
+	top, _ := s.Top(TopQuery{Limit: defaultTopLimit})
+	return top
+}
+
+// Top 5 within a service (global counters filtered by service)
+func (s *Store) TopFiveByService(service string) []*pb.TopFive {
+	top, _ := s.Top(TopQuery{Service: service, Limit: defaultTopLimit})
+	return top
 }
 
-// Sort by count desc, then lastUsed desc; take 5; convert to proto.
-func top5ToProto(rows []row) []*pb.TopFive {
-	if len(rows) == 0 {
+func (s *Store) TopFiveForSession(session string) []*pb.TopFive {
+	top, _ := s.Top(TopQuery{Session: session, Limit: defaultTopLimit})
+	return top
+}
+
+// Sort by count desc, then lastUsed desc; skip offset and take limit; convert to proto.
+func rankToProto(rows []row, offset, limit int) []*pb.TopFive {
+	if offset >= len(rows) || limit <= 0 {
 		return []*pb.TopFive{}
 	}
 	sort.Slice(rows, func(i, j int) bool {
 		if rows[i].count != rows[j].count {
 			return rows[i].count > rows[j].count
 		}
-		return rows[i].lastUsed.After(rows[j].lastUsed)
+		if !rows[i].lastUsed.Equal(rows[j].lastUsed) {
+			return rows[i].lastUsed.After(rows[j].lastUsed)
+		}
+		// keep pages stable when counts and timestamps tie
+		if rows[i].service != rows[j].service {
+			return rows[i].service < rows[j].service
+		}
+		return rows[i].word < rows[j].word
 	})
-	n := 5
-	if len(rows) < n {
-		n = len(rows)
+	end := offset + limit
+	if end > len(rows) {
+		end = len(rows)
 	}
-	out := make([]*pb.TopFive, 0, n)
-	for i := 0; i < n; i++ {
+	out := make([]*pb.TopFive, 0, end-offset)
+	for i := offset; i < end; i++ {
 		out = append(out, &pb.TopFive{
 			ServiceName: rows[i].service,
 			Word:        rows[i].word,
@@ -0,0 +1,527 @@
+package geometrias
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func event(session, service, searchType, word string, at time.Time) Event {
+	return Event{SessionID: session, Service: service, SearchType: searchType, Word: word, At: at}
+}
+
+func TestStoreTopFive(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("OrderedByCount", func(t *testing.T) {
+		store := NewStore()
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s2", "fuzzy", "fuzzy", "ἀγγέλλω", now))
+
+		sut := store.TopFiveGlobal()
+		assert.Len(t, sut, 2)
+		assert.Equal(t, "λόγος", sut[0].Word)
+		assert.Equal(t, int64(2), sut[0].Count)
+	})
+
+	t.Run("FilteredBySession", func(t *testing.T) {
+		store := NewStore()
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s2", "fuzzy", "fuzzy", "ἀγγέλλω", now))
+
+		sut := store.TopFiveForSession("s2")
+		assert.Len(t, sut, 1)
+		assert.Equal(t, "ἀγγέλλω", sut[0].Word)
+	})
+}
+
+func TestStoreTopFiveWindow(t *testing.T) {
+	now := time.Now().UTC()
+
+	store := NewStore()
+	for i := 0; i < 3; i++ {
+		store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-10*24*time.Hour)))
+	}
+	store.Inc(event("s1", "exact", "exact", "ἀγγέλλω", now.Add(-2*time.Hour)))
+	store.Inc(event("s2", "fuzzy", "fuzzy", "ἀγγέλλω", now))
+	store.Inc(event("s2", "fuzzy", "fuzzy", "φυλακή", now))
+
+	t.Run("Last24Hours", func(t *testing.T) {
+		sut := store.TopFiveWindow(24*time.Hour, "", now)
+		assert.Len(t, sut, 3)
+		for _, top := range sut {
+			assert.NotEqual(t, "λόγος", top.Word)
+		}
+	})
+
+	t.Run("Last30Days", func(t *testing.T) {
+		sut := store.TopFiveWindow(30*24*time.Hour, "", now)
+		assert.Equal(t, "λόγος", sut[0].Word)
+		assert.Equal(t, int64(3), sut[0].Count)
+	})
+
+	t.Run("FilteredByService", func(t *testing.T) {
+		sut := store.TopFiveWindow(time.Hour, "fuzzy", now)
+		assert.Len(t, sut, 2)
+		for _, top := range sut {
+			assert.Equal(t, "fuzzy", top.ServiceName)
+		}
+	})
+}
+
+func TestStoreStorage(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("RestoreFromMemory", func(t *testing.T) {
+		storage := NewMemoryStorage()
+		store, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		assert.Nil(t, store.Flush())
+
+		restored, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+
+		sut := restored.TopFiveForSession("s1")
+		assert.Len(t, sut, 1)
+		assert.Equal(t, int64(2), sut[0].Count)
+	})
+
+	t.Run("RestoreFromBadger", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "badger")
+		storage, err := NewBadgerStorage(path)
+		assert.Nil(t, err)
+
+		store, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+		assert.Len(t, store.TopFiveGlobal(), 0)
+
+		store.Inc(event("s1", "fuzzy", "fuzzy", "ἀγγέλλω", now))
+		assert.Nil(t, store.Close())
+
+		storage, err = NewBadgerStorage(path)
+		assert.Nil(t, err)
+		restored, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+
+		sut := restored.TopFiveGlobal()
+		assert.Len(t, sut, 1)
+		assert.Equal(t, "ἀγγέλλω", sut[0].Word)
+		assert.Nil(t, restored.Close())
+	})
+
+	t.Run("UnsupportedBackend", func(t *testing.T) {
+		_, err := NewStorage("redis", "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("UnhealthyWhileFlushFails", func(t *testing.T) {
+		storage := &failingStorage{MemoryStorage: NewMemoryStorage()}
+		store, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+		assert.True(t, store.Healthy())
+
+		storage.fail = true
+		assert.NotNil(t, store.Flush())
+		assert.False(t, store.Healthy())
+
+		storage.fail = false
+		assert.Nil(t, store.Flush())
+		assert.True(t, store.Healthy())
+	})
+}
+
+type blockingStorage struct {
+	*MemoryStorage
+	saving  chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingStorage) Save(snapshot *Snapshot) error {
+	b.saving <- struct{}{}
+	<-b.release
+	return b.MemoryStorage.Save(snapshot)
+}
+
+func TestStoreFlush(t *testing.T) {
+	t.Run("SavesInSnapshotOrder", func(t *testing.T) {
+		now := time.Now().UTC()
+		storage := &blockingStorage{
+			MemoryStorage: NewMemoryStorage(),
+			saving:        make(chan struct{}, 2),
+			release:       make(chan struct{}),
+		}
+		store, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		first := make(chan error)
+		go func() { first <- store.Flush() }()
+		<-storage.saving
+
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		second := make(chan error)
+		go func() { second <- store.Flush() }()
+
+		// the second flush waits for the first one instead of saving its newer snapshot first
+		select {
+		case <-storage.saving:
+			t.Fatal("second flush saved while the first one was still saving")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		storage.release <- struct{}{}
+		assert.Nil(t, <-first)
+		<-storage.saving
+		storage.release <- struct{}{}
+		assert.Nil(t, <-second)
+
+		snapshot, err := storage.Load()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), snapshot.Global[0].Count)
+	})
+}
+
+type failingStorage struct {
+	*MemoryStorage
+	fail bool
+}
+
+func (f *failingStorage) Save(snapshot *Snapshot) error {
+	if f.fail {
+		return errors.New("disk full")
+	}
+	return f.MemoryStorage.Save(snapshot)
+}
+
+func TestStoreTop(t *testing.T) {
+	now := time.Now().UTC()
+
+	store := NewStore()
+	for i := 0; i < 4; i++ {
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+	}
+	for i := 0; i < 3; i++ {
+		store.Inc(event("s1", "exact", "exact", "ἀγγέλλω", now))
+	}
+	store.Inc(event("s2", "exact", "expand", "ἀγγέλλω", now))
+	store.Inc(event("s2", "exact", "expand", "ἀγγέλλω", now))
+	store.Inc(event("s2", "fuzzy", "fuzzy", "φυλακή", now))
+	store.Inc(event("s2", "partial", "partial", "μάχη", now))
+
+	t.Run("Paged", func(t *testing.T) {
+		first, total := store.Top(TopQuery{Limit: 2})
+		assert.Equal(t, 4, total)
+		assert.Len(t, first, 2)
+		assert.Equal(t, "ἀγγέλλω", first[0].Word)
+		assert.Equal(t, int64(5), first[0].Count)
+
+		second, _ := store.Top(TopQuery{Limit: 2, Offset: 2})
+		assert.Len(t, second, 2)
+		assert.NotEqual(t, first[1].Word, second[0].Word)
+
+		beyond, total := store.Top(TopQuery{Limit: 2, Offset: 10})
+		assert.Equal(t, 4, total)
+		assert.Len(t, beyond, 0)
+	})
+
+	t.Run("FilteredBySearchType", func(t *testing.T) {
+		sut, total := store.Top(TopQuery{SearchType: "expand", Limit: 5})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, "ἀγγέλλω", sut[0].Word)
+		assert.Equal(t, int64(2), sut[0].Count)
+	})
+
+	t.Run("FilteredBySessionAndService", func(t *testing.T) {
+		sut, total := store.Top(TopQuery{Session: "s2", Service: "exact", Limit: 5})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, int64(2), sut[0].Count)
+	})
+}
+
+func TestStoreSessionEviction(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("IdleSessions", func(t *testing.T) {
+		store := NewStore()
+		store.LimitSessions(time.Hour, 0)
+		store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-3*time.Hour)))
+		store.Inc(event("s2", "exact", "exact", "λόγος", now.Add(-2*time.Hour)))
+		store.Inc(event("s3", "fuzzy", "fuzzy", "ἀγγέλλω", now.Add(-10*time.Minute)))
+
+		evicted := store.EvictIdleSessions(now)
+		assert.Equal(t, 2, evicted)
+		assert.Len(t, store.TopFiveForSession("s1"), 0)
+		assert.Len(t, store.TopFiveForSession("s3"), 1)
+
+		// global aggregates are kept
+		sut := store.TopFiveGlobal()
+		assert.Equal(t, int64(2), sut[0].Count)
+
+		stats := store.SessionStats()
+		assert.Equal(t, 1, stats.Active)
+		assert.Equal(t, int64(2), stats.EvictedIdle)
+	})
+
+	t.Run("LeastRecentlyUsedOverCapacity", func(t *testing.T) {
+		store := NewStore()
+		store.LimitSessions(0, 2)
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s2", "exact", "exact", "λόγος", now))
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s3", "exact", "exact", "λόγος", now))
+
+		assert.Len(t, store.TopFiveForSession("s1"), 1)
+		assert.Len(t, store.TopFiveForSession("s2"), 0)
+		assert.Len(t, store.TopFiveForSession("s3"), 1)
+		assert.Equal(t, int64(1), store.SessionStats().EvictedCapacity)
+	})
+
+	t.Run("CapacityAppliedOnRestore", func(t *testing.T) {
+		storage := NewMemoryStorage()
+		store, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+		store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-time.Hour)))
+		store.Inc(event("s2", "exact", "exact", "λόγος", now))
+		assert.Nil(t, store.Flush())
+
+		restored, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+		restored.LimitSessions(0, 1)
+
+		assert.Len(t, restored.TopFiveForSession("s1"), 0)
+		assert.Len(t, restored.TopFiveForSession("s2"), 1)
+	})
+}
+
+func TestStoreZeroResults(t *testing.T) {
+	now := time.Now().UTC()
+	hits := func(n int64) *int64 { return &n }
+
+	store := NewStore()
+	store.Inc(Event{SessionID: "s1", Service: "exact", Language: "LANG_GREEK", Word: "ἀρετή", ResultCount: hits(3), At: now})
+	store.Inc(Event{SessionID: "s1", Service: "exact", Language: "LANG_GREEK", Word: "ξενοφῶν", ResultCount: hits(0), At: now})
+	store.Inc(Event{SessionID: "s2", Service: "exact", Language: "LANG_GREEK", Word: "ξενοφῶν", ResultCount: hits(0), At: now})
+	store.Inc(Event{SessionID: "s2", Service: "fuzzy", Language: "LANG_ENGLISH", Word: "virtue", ResultCount: hits(0), At: now})
+	// searches without a result count are counted but never ranked as empty
+	store.Inc(Event{SessionID: "s3", Service: "exact", Language: "LANG_GREEK", Word: "λόγος", At: now})
+
+	t.Run("RankedByEmptySearches", func(t *testing.T) {
+		sut, total := store.Top(TopQuery{ZeroResults: true, Limit: 5})
+		assert.Equal(t, 2, total)
+		assert.Equal(t, "ξενοφῶν", sut[0].Word)
+		assert.Equal(t, int64(2), sut[0].Count)
+	})
+
+	t.Run("FilteredByLanguage", func(t *testing.T) {
+		sut, total := store.Top(TopQuery{ZeroResults: true, Language: "LANG_ENGLISH", Limit: 5})
+		assert.Equal(t, 1, total)
+		assert.Equal(t, "virtue", sut[0].Word)
+	})
+
+	t.Run("HitsSummed", func(t *testing.T) {
+		snapshot := store.Snapshot()
+		for _, e := range snapshot.Global {
+			if e.Word == "ἀρετή" {
+				assert.Equal(t, int64(3), e.Hits)
+				assert.Equal(t, int64(0), e.ZeroResults)
+			}
+		}
+	})
+}
+
+func TestStoreExport(t *testing.T) {
+	now := time.Now().UTC()
+
+	store := NewStore()
+	store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-48*time.Hour)))
+	store.Inc(event("s1", "exact", "exact", "ἀγγέλλω", now))
+	store.Inc(event("s2", "fuzzy", "fuzzy", "φυλακή", now))
+
+	export := func(q ExportQuery) []*pb.ExportedCounter {
+		var out []*pb.ExportedCounter
+		err := store.Export(q, func(c *pb.ExportedCounter) error {
+			out = append(out, c)
+			return nil
+		})
+		assert.Nil(t, err)
+		return out
+	}
+
+	t.Run("AllScopes", func(t *testing.T) {
+		sut := export(ExportQuery{Global: true, Session: true})
+		assert.Len(t, sut, 6)
+	})
+
+	t.Run("SessionSinceYesterday", func(t *testing.T) {
+		sut := export(ExportQuery{Session: true, Since: now.Add(-24 * time.Hour)})
+		assert.Len(t, sut, 2)
+		for _, c := range sut {
+			assert.Equal(t, scopeSession, c.Scope)
+			assert.NotEmpty(t, c.SessionId)
+		}
+	})
+
+	t.Run("GlobalForService", func(t *testing.T) {
+		sut := export(ExportQuery{Global: true, Service: "fuzzy"})
+		assert.Len(t, sut, 1)
+		assert.Equal(t, "φυλακή", sut[0].Word)
+		assert.Equal(t, int64(1), sut[0].SearchTypes["fuzzy"])
+	})
+}
+
+func TestStoreTrending(t *testing.T) {
+	now := time.Now().UTC()
+
+	store := NewStore()
+	// a steady word: fifteen searches a day for the last twenty days
+	for day := 1; day <= 20; day++ {
+		for i := 0; i < 15; i++ {
+			store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-time.Duration(day)*24*time.Hour-3*time.Hour)))
+		}
+	}
+	for i := 0; i < 10; i++ {
+		store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-time.Hour)))
+	}
+	// a new word the whole class is looking up
+	for i := 0; i < 10; i++ {
+		store.Inc(event("s2", "exact", "exact", "ἀνάβασις", now.Add(-time.Hour)))
+	}
+	store.Inc(event("s3", "fuzzy", "fuzzy", "φυλακή", now))
+
+	t.Run("SpikeRanksFirst", func(t *testing.T) {
+		sut := store.Trending(24*time.Hour, "", 3, 10, now)
+		assert.Len(t, sut, 2)
+		assert.Equal(t, "ἀνάβασις", sut[0].Word)
+		assert.Equal(t, int64(10), sut[0].Recent)
+		assert.Equal(t, float64(11), sut[0].Score)
+
+		assert.Equal(t, "λόγος", sut[1].Word)
+		assert.Less(t, sut[1].Score, 1.0)
+	})
+
+	t.Run("BaselineExcludesTheWindow", func(t *testing.T) {
+		// two hours into the day, so the window starts in the middle of yesterday's daily bucket
+		now := time.Date(2024, 3, 10, 2, 0, 0, 0, time.UTC)
+		store := NewStore()
+		for day := 2; day <= 29; day++ {
+			store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-time.Duration(day)*24*time.Hour)))
+		}
+		// yesterday, before the window, and today within it
+		store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-25*time.Hour)))
+		for i := 0; i < 4; i++ {
+			store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-time.Hour)))
+		}
+
+		sut := store.Trending(24*time.Hour, "", 1, 10, now)
+		assert.Len(t, sut, 1)
+		assert.Equal(t, int64(4), sut[0].Recent)
+		assert.Equal(t, 1.0, sut[0].Baseline)
+		assert.Equal(t, 2.5, sut[0].Score)
+
+		weekly := store.Trending(7*24*time.Hour, "", 1, 10, now)
+		assert.Len(t, weekly, 1)
+		// the daily bucket the week starts in is part of the window, the 28 whole days before it are the baseline
+		assert.Equal(t, int64(4+1+6), weekly[0].Recent)
+	})
+
+	t.Run("WeeklyBaselineIsKept", func(t *testing.T) {
+		now := time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC)
+		store := NewStore()
+		// one search a day for longer than the daily buckets are kept, oldest first so pruning runs as it would live
+		for day := 45; day >= 0; day-- {
+			store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-time.Duration(day)*24*time.Hour)))
+		}
+
+		weekly := store.Trending(7*24*time.Hour, "", 1, 10, now)
+		assert.Len(t, weekly, 1)
+		// all 28 baseline days are still there, one search each
+		assert.Equal(t, 7.0, weekly[0].Baseline)
+	})
+
+	t.Run("FilteredByService", func(t *testing.T) {
+		sut := store.Trending(24*time.Hour, "fuzzy", 1, 10, now)
+		assert.Len(t, sut, 1)
+		assert.Equal(t, "φυλακή", sut[0].Word)
+	})
+}
+
+func TestStorePrivacy(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("PseudonymisedSessions", func(t *testing.T) {
+		hasher, err := NewSessionHasher(SessionHMAC, "secret")
+		assert.Nil(t, err)
+
+		store := NewStore()
+		store.PseudonymiseSessions(hasher)
+		store.Inc(event("student-42", "exact", "exact", "λόγος", now))
+
+		// lookups use the id from the header
+		assert.Len(t, store.TopFiveForSession("student-42"), 1)
+		for _, e := range store.Snapshot().Session {
+			assert.NotEqual(t, "student-42", e.Session)
+			assert.Equal(t, hasher("student-42"), e.Session)
+		}
+	})
+
+	t.Run("ForgetSession", func(t *testing.T) {
+		store := NewStore()
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s1", "fuzzy", "fuzzy", "ἀγγέλλω", now))
+		store.Inc(event("s2", "exact", "exact", "λόγος", now))
+
+		assert.Equal(t, 2, store.ForgetSession("s1"))
+		assert.Len(t, store.TopFiveForSession("s1"), 0)
+		assert.Len(t, store.TopFiveForSession("s2"), 1)
+		assert.Equal(t, int64(2), store.TopFiveGlobal()[0].Count)
+		assert.Equal(t, int64(2), store.SessionStats().Forgotten)
+	})
+
+	t.Run("ForgetSessionRestoredInPlainMode", func(t *testing.T) {
+		storage := NewMemoryStorage()
+		plain, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+		plain.Inc(event("s1", "exact", "exact", "λόγος", now))
+		assert.Nil(t, plain.Flush())
+
+		hasher, err := NewSessionHasher(SessionSHA256, "")
+		assert.Nil(t, err)
+		store, err := NewStoreWithStorage(storage)
+		assert.Nil(t, err)
+		store.PseudonymiseSessions(hasher)
+		store.Inc(event("s1", "fuzzy", "fuzzy", "ἀγγέλλω", now))
+
+		assert.Equal(t, 2, store.ForgetSession("s1"))
+		assert.Empty(t, store.Snapshot().Session)
+	})
+
+	t.Run("RetentionKeepsGlobalAggregates", func(t *testing.T) {
+		store := NewStore()
+		store.RetainSessions(7 * 24 * time.Hour)
+		store.Inc(event("s1", "exact", "exact", "λόγος", now.Add(-10*24*time.Hour)))
+		// still in use, but first seen before the retention period
+		store.Inc(event("s1", "exact", "exact", "λόγος", now))
+		store.Inc(event("s2", "exact", "exact", "λόγος", now.Add(-time.Hour)))
+
+		assert.Equal(t, 1, store.ExpireSessions(now))
+		assert.Len(t, store.TopFiveForSession("s1"), 0)
+		assert.Len(t, store.TopFiveForSession("s2"), 1)
+		assert.Equal(t, int64(3), store.TopFiveGlobal()[0].Count)
+	})
+
+	t.Run("UnsupportedMode", func(t *testing.T) {
+		_, err := NewSessionHasher("base64", "")
+		assert.NotNil(t, err)
+
+		_, err = NewSessionHasher(SessionHMAC, "")
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,135 @@
+package geometrias
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+)
+
+const (
+	// trendBaseline is how far back the daily buckets are read to learn what is normal for a word.
+	trendBaseline = 28 * 24 * time.Hour
+	// longestTrendWindow is the longest window trending accepts, LAST_7D.
+	longestTrendWindow = 7 * 24 * time.Hour
+
+	defaultTrendLimit    = 10
+	defaultTrendMinCount = 3
+)
+
+// Trend is a word whose count in the recent window is compared to its baseline.
+type Trend struct {
+	GlobalKey
+	Recent   int64
+	Baseline float64
+	Score    float64
+	LastUsed time.Time
+}
+
+// Trending scores every word searched at least minCount times within span by how far its count exceeds the
+// average count of a span of the same length over the baseline, the whole days before the day the window starts in.
+// Words without history score highest.
+func (s *Store) Trending(span time.Duration, service string, minCount int64, limit int, now time.Time) []Trend {
+	cutoff := now.Add(-span)
+
+	s.mu.RLock()
+	// only words used within the window can trend, which LastUsed tells us without touching the buckets
+	include := func(k GlobalKey) bool {
+		if service != "" && k.Service != service {
+			return false
+		}
+		c := s.global[k]
+		return c != nil && c.LastUsed.After(cutoff)
+	}
+
+	source := s.hourly
+	if span > hourlyRetention {
+		source = s.daily
+	}
+	recent := source.sum(span, now, include)
+	// whole daily buckets only, ending where the bucket holding the start of the window begins, so no search is
+	// counted in both the window and the baseline whatever the resolution of recent
+	historyEnd := cutoff.UTC().Truncate(s.daily.size)
+	history := s.daily.between(historyEnd.Add(-trendBaseline), historyEnd, include)
+
+	out := make([]Trend, 0, len(recent))
+	for k, count := range recent {
+		if count < minCount {
+			continue
+		}
+
+		baseline := float64(history[k]) * float64(span) / float64(trendBaseline)
+
+		out = append(out, Trend{
+			GlobalKey: k,
+			Recent:    count,
+			Baseline:  baseline,
+			Score:     (float64(count) + 1) / (baseline + 1),
+			LastUsed:  s.global[k].LastUsed,
+		})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		if out[i].Recent != out[j].Recent {
+			return out[i].Recent > out[j].Recent
+		}
+		return out[i].Word < out[j].Word
+	})
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// RetrieveTrending returns the words whose usage in the requested window spikes compared to their baseline.
+func (c *CounterServiceImpl) RetrieveTrending(ctx context.Context, in *pb.TrendingRequest) (*pb.TrendingResponse, error) {
+	window := in.Window
+	if window == pb.Window_ALL_TIME {
+		window = pb.Window_LAST_24H
+	}
+	span, _ := windowSpan(window)
+	if span > longestTrendWindow {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidArgument, errors.New("trending needs a window shorter than its 28 day baseline"))
+	}
+
+	if in.Limit < 0 || in.MinCount < 0 {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidPage, errors.New("limit and min_count must not be negative"))
+	}
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = defaultTrendLimit
+	}
+	if limit > maxTopLimit {
+		limit = maxTopLimit
+	}
+
+	minCount := in.MinCount
+	if minCount == 0 {
+		minCount = defaultTrendMinCount
+	}
+
+	trends := c.store.Trending(span, in.ServiceName, minCount, limit, time.Now().UTC())
+
+	out := make([]*pb.TrendingWord, 0, len(trends))
+	for _, trend := range trends {
+		out = append(out, &pb.TrendingWord{
+			ServiceName:     trend.Service,
+			Word:            trend.Word,
+			RecentCount:     trend.Recent,
+			BaselineAverage: trend.Baseline,
+			Score:           trend.Score,
+			LastUsed:        trend.LastUsed.UTC().Format(time.RFC3339Nano),
+		})
+	}
+
+	return &pb.TrendingResponse{Trending: out}, nil
+}
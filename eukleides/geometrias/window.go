@@ -0,0 +1,155 @@
+package geometrias
+
+import (
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+)
+
+const (
+	hourlyRetention = 48 * time.Hour
+	// dailyRetention keeps the baseline of the longest trending window, which starts on the whole day before that
+	// window, and so also covers LAST_30D.
+	dailyRetention = trendBaseline + longestTrendWindow + 24*time.Hour
+)
+
+// buckets holds per-key counts grouped by the start (unix seconds) of a fixed-size time slot.
+type buckets struct {
+	size      time.Duration
+	retention time.Duration
+	slots     map[int64]map[GlobalKey]int64
+}
+
+type BucketEntry struct {
+	Start int64 `json:"start"`
+	GlobalKey
+	Count int64 `json:"count"`
+}
+
+func newBuckets(size, retention time.Duration) *buckets {
+	return &buckets{
+		size:      size,
+		retention: retention,
+		slots:     make(map[int64]map[GlobalKey]int64, int(retention/size)+1),
+	}
+}
+
+func (b *buckets) inc(key GlobalKey, ts time.Time) {
+	start := ts.UTC().Truncate(b.size).Unix()
+	slot := b.slots[start]
+	if slot == nil {
+		slot = make(map[GlobalKey]int64, 64)
+		b.slots[start] = slot
+		// a new slot is the natural moment to drop the ones that fell out of retention
+		b.prune(ts)
+	}
+	slot[key]++
+}
+
+func (b *buckets) prune(now time.Time) {
+	cutoff := now.UTC().Add(-b.retention).Truncate(b.size).Unix()
+	for start := range b.slots {
+		if start < cutoff {
+			delete(b.slots, start)
+		}
+	}
+}
+
+// sum adds up all slots that overlap (now-span, now], so the window is exact up to the size of one bucket.
+func (b *buckets) sum(span time.Duration, now time.Time, include func(GlobalKey) bool) map[GlobalKey]int64 {
+	cutoff := now.UTC().Add(-span).Unix()
+	size := int64(b.size / time.Second)
+
+	out := make(map[GlobalKey]int64, 64)
+	for start, slot := range b.slots {
+		if start+size <= cutoff {
+			continue
+		}
+		for k, count := range slot {
+			if include(k) {
+				out[k] += count
+			}
+		}
+	}
+	return out
+}
+
+// between adds up the slots starting within [from, to).
+func (b *buckets) between(from, to time.Time, include func(GlobalKey) bool) map[GlobalKey]int64 {
+	first, last := from.UTC().Unix(), to.UTC().Unix()
+
+	out := make(map[GlobalKey]int64, 64)
+	for start, slot := range b.slots {
+		if start < first || start >= last {
+			continue
+		}
+		for k, count := range slot {
+			if include(k) {
+				out[k] += count
+			}
+		}
+	}
+	return out
+}
+
+func (b *buckets) entries() []BucketEntry {
+	out := make([]BucketEntry, 0, len(b.slots))
+	for start, slot := range b.slots {
+		for k, count := range slot {
+			out = append(out, BucketEntry{Start: start, GlobalKey: k, Count: count})
+		}
+	}
+	return out
+}
+
+func (b *buckets) restore(entries []BucketEntry) {
+	for _, e := range entries {
+		slot := b.slots[e.Start]
+		if slot == nil {
+			slot = make(map[GlobalKey]int64, 64)
+			b.slots[e.Start] = slot
+		}
+		slot[e.GlobalKey] += e.Count
+	}
+}
+
+// windowSpan maps a proto window onto its duration; ALL_TIME has no span.
+func windowSpan(window pb.Window) (time.Duration, bool) {
+	switch window {
+	case pb.Window_LAST_HOUR:
+		return time.Hour, true
+	case pb.Window_LAST_24H:
+		return 24 * time.Hour, true
+	case pb.Window_LAST_7D:
+		return 7 * 24 * time.Hour, true
+	case pb.Window_LAST_30D:
+		return 30 * 24 * time.Hour, true
+	default:
+		return 0, false
+	}
+}
+
+// TopFiveWindow ranks global counters (optionally within one service) by their count over the trailing span.
+func (s *Store) TopFiveWindow(span time.Duration, service string, now time.Time) []*pb.TopFive {
+	include := func(k GlobalKey) bool {
+		return service == "" || k.Service == service
+	}
+
+	s.mu.RLock()
+	source := s.hourly
+	if span > hourlyRetention {
+		source = s.daily
+	}
+	counts := source.sum(span, now, include)
+
+	out := make([]row, 0, len(counts))
+	for k, count := range counts {
+		r := row{service: k.Service, word: k.Word, count: count}
+		if c := s.global[k]; c != nil {
+			r.lastUsed = c.LastUsed
+		}
+		out = append(out, r)
+	}
+	s.mu.RUnlock()
+	return rankToProto(out, 0, defaultTopLimit)
+}
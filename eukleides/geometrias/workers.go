@@ -0,0 +1,101 @@
+package geometrias
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
+	"google.golang.org/grpc/status"
+)
+
+// workerPool applies count batches to the store with a fixed number of workers. Submitting blocks while the queue
+// is full, which pushes back on the client stream instead of piling up goroutines.
+type workerPool struct {
+	store *Store
+	jobs  chan job
+	wg    sync.WaitGroup
+
+	// mu guards closed and makes sure nothing is sent on jobs once it is closed
+	mu     sync.RWMutex
+	closed bool
+}
+
+type job struct {
+	set     *pb.CountCreationRequestSet
+	applied chan int64
+}
+
+func newWorkerPool(store *Store, workers, queueSize int) *workerPool {
+	p := &workerPool{
+		store: store,
+		jobs:  make(chan job, queueSize),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer p.wg.Done()
+			for j := range p.jobs {
+				j.applied <- p.apply(j.set)
+			}
+		}()
+	}
+
+	return p
+}
+
+// submit queues set and returns a channel that yields the number of applied events once the set is done.
+func (p *workerPool) submit(ctx context.Context, set *pb.CountCreationRequestSet) (<-chan int64, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return nil, hamartia.Unavailable(hamartia.ReasonShuttingDown, "eukleides is shutting down")
+	}
+
+	j := job{set: set, applied: make(chan int64, 1)}
+	select {
+	case p.jobs <- j:
+		return j.applied, nil
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (p *workerPool) apply(set *pb.CountCreationRequestSet) int64 {
+	now := time.Now().UTC()
+	var applied int64
+	for _, req := range set.Request {
+		if req.Word == "" || req.ServiceName == "" {
+			continue
+		}
+
+		p.store.Inc(Event{
+			SessionID:   req.SessionId,
+			Service:     req.ServiceName,
+			SearchType:  req.SearchType,
+			Language:    req.Language,
+			Word:        req.Word,
+			ResultCount: req.ResultCount,
+			At:          now,
+		})
+		applied++
+	}
+	return applied
+}
+
+// close stops accepting batches and waits until every queued batch has been applied.
+func (p *workerPool) close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.jobs)
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
@@ -6,12 +6,15 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
 	"github.com/odysseia-greek/makedonia/eukleides/geometrias"
 	pb "github.com/odysseia-greek/makedonia/eukleides/proto"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	"google.golang.org/grpc"
 )
 
@@ -47,6 +50,11 @@ func main() {
 		log.Fatal("death has found me")
 	}
 
+	shutdown, err := hygieia.ShutdownConfigFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -66,9 +74,29 @@ func main() {
 	)
 
 	pb.RegisterEukleidesServer(server, cfg)
+	health := hygieia.Register(ctx, server, pb.Eukleides_ServiceDesc.ServiceName, cfg.Healthy, hygieia.Config{
+		Reflection: config.BoolFromEnv("ENABLE_REFLECTION"),
+	})
+
+	go func() {
+		logging.Info(fmt.Sprintf("Server listening on %s", port))
+		if err := server.Serve(listener); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
 
-	logging.Info(fmt.Sprintf("Server listening on %s", port))
-	if err := server.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	// the ingester of every alexandros keeps a stream open, GracefulStop alone would wait for it forever. Ending the
+	// streams acks what arrived, the ingesters resend the rest to another replica.
+	logging.System("shutting down and flushing counters")
+	cfg.EndStreams()
+	if !hygieia.GracefulStop(server, health, shutdown) {
+		logging.Error("in-flight requests did not finish in time and were cut off")
+	}
+	if err := cfg.Close(); err != nil {
+		logging.Error(err.Error())
 	}
 }
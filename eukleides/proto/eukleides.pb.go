@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.28.1
-// 	protoc        v6.32.1
+// 	protoc        (unknown)
 // source: proto/eukleides.proto
 
 package proto
@@ -20,12 +20,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Window is a trailing time range; hourly buckets back the short windows, daily buckets the long ones.
+type Window int32
+
+const (
+	Window_ALL_TIME  Window = 0
+	Window_LAST_HOUR Window = 1
+	Window_LAST_24H  Window = 2
+	Window_LAST_7D   Window = 3
+	Window_LAST_30D  Window = 4
+)
+
+// Enum value maps for Window.
+var (
+	Window_name = map[int32]string{
+		0: "ALL_TIME",
+		1: "LAST_HOUR",
+		2: "LAST_24H",
+		3: "LAST_7D",
+		4: "LAST_30D",
+	}
+	Window_value = map[string]int32{
+		"ALL_TIME":  0,
+		"LAST_HOUR": 1,
+		"LAST_24H":  2,
+		"LAST_7D":   3,
+		"LAST_30D":  4,
+	}
+)
+
+func (x Window) Enum() *Window {
+	p := new(Window)
+	*p = x
+	return p
+}
+
+func (x Window) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Window) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_eukleides_proto_enumTypes[0].Descriptor()
+}
+
+func (Window) Type() protoreflect.EnumType {
+	return &file_proto_eukleides_proto_enumTypes[0]
+}
+
+func (x Window) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Window.Descriptor instead.
+func (Window) EnumDescriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportScope int32
+
+const (
+	ExportScope_EXPORT_ALL     ExportScope = 0
+	ExportScope_EXPORT_GLOBAL  ExportScope = 1
+	ExportScope_EXPORT_SESSION ExportScope = 2
+)
+
+// Enum value maps for ExportScope.
+var (
+	ExportScope_name = map[int32]string{
+		0: "EXPORT_ALL",
+		1: "EXPORT_GLOBAL",
+		2: "EXPORT_SESSION",
+	}
+	ExportScope_value = map[string]int32{
+		"EXPORT_ALL":     0,
+		"EXPORT_GLOBAL":  1,
+		"EXPORT_SESSION": 2,
+	}
+)
+
+func (x ExportScope) Enum() *ExportScope {
+	p := new(ExportScope)
+	*p = x
+	return p
+}
+
+func (x ExportScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_eukleides_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportScope) Type() protoreflect.EnumType {
+	return &file_proto_eukleides_proto_enumTypes[1]
+}
+
+func (x ExportScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportScope.Descriptor instead.
+func (ExportScope) EnumDescriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{1}
+}
+
 type CountCreationRequestSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Request []*CountCreationRequest `protobuf:"bytes,1,rep,name=request,proto3" json:"request,omitempty"`
+	// client chosen id echoed in the CountBatchAck of CreateNewEntryStream
+	BatchId string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 }
 
 func (x *CountCreationRequestSet) Reset() {
@@ -67,6 +174,13 @@ func (x *CountCreationRequestSet) GetRequest() []*CountCreationRequest {
 	return nil
 }
 
+func (x *CountCreationRequestSet) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
 type CountCreationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -76,6 +190,10 @@ type CountCreationRequest struct {
 	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
 	SearchType  string `protobuf:"bytes,3,opt,name=search_type,json=searchType,proto3" json:"search_type,omitempty"`
 	SessionId   string `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// koinos.v1.Language name, empty when the search has no language
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// number of results the search returned; unset when the gateway could not tell (e.g. the search failed)
+	ResultCount *int64 `protobuf:"varint,6,opt,name=result_count,json=resultCount,proto3,oneof" json:"result_count,omitempty"`
 }
 
 func (x *CountCreationRequest) Reset() {
@@ -138,12 +256,28 @@ func (x *CountCreationRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *CountCreationRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CountCreationRequest) GetResultCount() int64 {
+	if x != nil && x.ResultCount != nil {
+		return *x.ResultCount
+	}
+	return 0
+}
+
 type CountStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Ack string `protobuf:"bytes,1,opt,name=ack,proto3" json:"ack,omitempty"`
+	// number of events applied over the whole stream; events without a word or service are skipped
+	Applied int64 `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
 }
 
 func (x *CountStreamResponse) Reset() {
@@ -185,6 +319,68 @@ func (x *CountStreamResponse) GetAck() string {
 	return ""
 }
 
+func (x *CountStreamResponse) GetApplied() int64 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+type CountBatchAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Applied int64  `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *CountBatchAck) Reset() {
+	*x = CountBatchAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountBatchAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBatchAck) ProtoMessage() {}
+
+func (x *CountBatchAck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBatchAck.ProtoReflect.Descriptor instead.
+func (*CountBatchAck) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CountBatchAck) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CountBatchAck) GetApplied() int64 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
 type TopFiveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -194,7 +390,7 @@ type TopFiveRequest struct {
 func (x *TopFiveRequest) Reset() {
 	*x = TopFiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[3]
+		mi := &file_proto_eukleides_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -207,7 +403,7 @@ func (x *TopFiveRequest) String() string {
 func (*TopFiveRequest) ProtoMessage() {}
 
 func (x *TopFiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[3]
+	mi := &file_proto_eukleides_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +416,7 @@ func (x *TopFiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopFiveRequest.ProtoReflect.Descriptor instead.
 func (*TopFiveRequest) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{3}
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{4}
 }
 
 type TopFiveServiceRequest struct {
@@ -234,7 +430,7 @@ type TopFiveServiceRequest struct {
 func (x *TopFiveServiceRequest) Reset() {
 	*x = TopFiveServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[4]
+		mi := &file_proto_eukleides_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -247,7 +443,7 @@ func (x *TopFiveServiceRequest) String() string {
 func (*TopFiveServiceRequest) ProtoMessage() {}
 
 func (x *TopFiveServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[4]
+	mi := &file_proto_eukleides_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +456,7 @@ func (x *TopFiveServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopFiveServiceRequest.ProtoReflect.Descriptor instead.
 func (*TopFiveServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{4}
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TopFiveServiceRequest) GetName() string {
@@ -281,7 +477,7 @@ type TopFiveSessionRequest struct {
 func (x *TopFiveSessionRequest) Reset() {
 	*x = TopFiveSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[5]
+		mi := &file_proto_eukleides_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +490,7 @@ func (x *TopFiveSessionRequest) String() string {
 func (*TopFiveSessionRequest) ProtoMessage() {}
 
 func (x *TopFiveSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[5]
+	mi := &file_proto_eukleides_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +503,7 @@ func (x *TopFiveSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopFiveSessionRequest.ProtoReflect.Descriptor instead.
 func (*TopFiveSessionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{5}
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TopFiveSessionRequest) GetSessionId() string {
@@ -317,31 +513,33 @@ func (x *TopFiveSessionRequest) GetSessionId() string {
 	return ""
 }
 
-type TopFiveResponse struct {
+type TopFiveWindowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TopFive []*TopFive `protobuf:"bytes,1,rep,name=top_five,json=topFive,proto3" json:"top_five,omitempty"`
+	Window Window `protobuf:"varint,1,opt,name=window,proto3,enum=makedonia_eukleides.Window" json:"window,omitempty"`
+	// optional, empty means all services
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
 }
 
-func (x *TopFiveResponse) Reset() {
-	*x = TopFiveResponse{}
+func (x *TopFiveWindowRequest) Reset() {
+	*x = TopFiveWindowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[6]
+		mi := &file_proto_eukleides_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopFiveResponse) String() string {
+func (x *TopFiveWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopFiveResponse) ProtoMessage() {}
+func (*TopFiveWindowRequest) ProtoMessage() {}
 
-func (x *TopFiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[6]
+func (x *TopFiveWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,46 +550,57 @@ func (x *TopFiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopFiveResponse.ProtoReflect.Descriptor instead.
-func (*TopFiveResponse) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TopFiveWindowRequest.ProtoReflect.Descriptor instead.
+func (*TopFiveWindowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TopFiveResponse) GetTopFive() []*TopFive {
+func (x *TopFiveWindowRequest) GetWindow() Window {
 	if x != nil {
-		return x.TopFive
+		return x.Window
 	}
-	return nil
+	return Window_ALL_TIME
 }
 
-type TopFive struct {
+func (x *TopFiveWindowRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+// TopRequest pages through the ranking; every filter is optional and an empty string matches everything.
+type TopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Word        string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
-	LastUsed    string `protobuf:"bytes,3,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
-	Count       int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// defaults to 5 when zero, capped at 100
+	Limit       int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	ServiceName string `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	SearchType  string `protobuf:"bytes,4,opt,name=search_type,json=searchType,proto3" json:"search_type,omitempty"`
+	// ranks the counters of a single session instead of the global ones
+	SessionId string `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (x *TopFive) Reset() {
-	*x = TopFive{}
+func (x *TopRequest) Reset() {
+	*x = TopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[7]
+		mi := &file_proto_eukleides_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopFive) String() string {
+func (x *TopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopFive) ProtoMessage() {}
+func (*TopRequest) ProtoMessage() {}
 
-func (x *TopFive) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[7]
+func (x *TopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,66 +611,75 @@ func (x *TopFive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopFive.ProtoReflect.Descriptor instead.
-func (*TopFive) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use TopRequest.ProtoReflect.Descriptor instead.
+func (*TopRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TopFive) GetServiceName() string {
+func (x *TopRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ServiceName
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *TopFive) GetWord() string {
+func (x *TopRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Word
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TopRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *TopFive) GetLastUsed() string {
+func (x *TopRequest) GetSearchType() string {
 	if x != nil {
-		return x.LastUsed
+		return x.SearchType
 	}
 	return ""
 }
 
-func (x *TopFive) GetCount() int64 {
+func (x *TopRequest) GetSessionId() string {
 	if x != nil {
-		return x.Count
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-type HealthResponse struct {
+type TopResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Healthy bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	Time    string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Entries []*TopFive `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// number of ranked entries matching the filters, independent of limit and offset
+	Total  int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
+func (x *TopResponse) Reset() {
+	*x = TopResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[8]
+		mi := &file_proto_eukleides_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthResponse) String() string {
+func (x *TopResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (*TopResponse) ProtoMessage() {}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[8]
+func (x *TopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,55 +690,70 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TopResponse.ProtoReflect.Descriptor instead.
+func (*TopResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *HealthResponse) GetHealthy() bool {
+func (x *TopResponse) GetEntries() []*TopFive {
 	if x != nil {
-		return x.Healthy
+		return x.Entries
 	}
-	return false
+	return nil
 }
 
-func (x *HealthResponse) GetTime() string {
+func (x *TopResponse) GetTotal() int64 {
 	if x != nil {
-		return x.Time
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *HealthResponse) GetVersion() string {
+func (x *TopResponse) GetLimit() int32 {
 	if x != nil {
-		return x.Version
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type HealthRequest struct {
+func (x *TopResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ZeroResultsRequest pages through the words whose searches returned nothing, most frequent first.
+type ZeroResultsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// defaults to 5 when zero, capped at 100
+	Limit       int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	ServiceName string `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// koinos.v1.Language name, e.g. LANG_GREEK
+	Language string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *HealthRequest) Reset() {
-	*x = HealthRequest{}
+func (x *ZeroResultsRequest) Reset() {
+	*x = ZeroResultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eukleides_proto_msgTypes[9]
+		mi := &file_proto_eukleides_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthRequest) String() string {
+func (x *ZeroResultsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthRequest) ProtoMessage() {}
+func (*ZeroResultsRequest) ProtoMessage() {}
 
-func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eukleides_proto_msgTypes[9]
+func (x *ZeroResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,70 +764,1053 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
-func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_eukleides_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ZeroResultsRequest.ProtoReflect.Descriptor instead.
+func (*ZeroResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{10}
 }
 
-var File_proto_eukleides_proto protoreflect.FileDescriptor
+func (x *ZeroResultsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
 
-var file_proto_eukleides_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e,
-	0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x17,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64,
-	0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a,
-	0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x13,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x61, 0x63, 0x6b, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x46, 0x69,
-	0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x0f,
-	0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x37, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75,
-	0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x52,
-	0x07, 0x74, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x22, 0x73, 0x0a, 0x07, 0x54, 0x6f, 0x70, 0x46,
-	0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x58, 0x0a,
-	0x0e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x81, 0x04, 0x0a, 0x09, 0x45, 0x75, 0x6b,
-	0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64,
-	0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e,
-	0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x28, 0x01, 0x12, 0x5e, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x6f,
-	0x70, 0x46, 0x69, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69,
+func (x *ZeroResultsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ZeroResultsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ZeroResultsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// TrendingRequest compares the counts of the recent window against the average of the 28 days before it.
+type TrendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// LAST_HOUR, LAST_24H (default) or LAST_7D
+	Window Window `protobuf:"varint,1,opt,name=window,proto3,enum=makedonia_eukleides.Window" json:"window,omitempty"`
+	// optional, empty means all services
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// defaults to 10 when zero, capped at 100
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// words searched fewer times in the window are ignored; defaults to 3 when zero
+	MinCount int64 `protobuf:"varint,4,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+}
+
+func (x *TrendingRequest) Reset() {
+	*x = TrendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingRequest) ProtoMessage() {}
+
+func (x *TrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingRequest.ProtoReflect.Descriptor instead.
+func (*TrendingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TrendingRequest) GetWindow() Window {
+	if x != nil {
+		return x.Window
+	}
+	return Window_ALL_TIME
+}
+
+func (x *TrendingRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TrendingRequest) GetMinCount() int64 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+type TrendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trending []*TrendingWord `protobuf:"bytes,1,rep,name=trending,proto3" json:"trending,omitempty"`
+}
+
+func (x *TrendingResponse) Reset() {
+	*x = TrendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingResponse) ProtoMessage() {}
+
+func (x *TrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingResponse.ProtoReflect.Descriptor instead.
+func (*TrendingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TrendingResponse) GetTrending() []*TrendingWord {
+	if x != nil {
+		return x.Trending
+	}
+	return nil
+}
+
+type TrendingWord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Word        string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	RecentCount int64  `protobuf:"varint,3,opt,name=recent_count,json=recentCount,proto3" json:"recent_count,omitempty"`
+	// expected count for a window of the same length, based on the baseline
+	BaselineAverage float64 `protobuf:"fixed64,4,opt,name=baseline_average,json=baselineAverage,proto3" json:"baseline_average,omitempty"`
+	// (recent_count + 1) / (baseline_average + 1); above 1 means the word is searched more than usual
+	Score    float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	LastUsed string  `protobuf:"bytes,6,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+}
+
+func (x *TrendingWord) Reset() {
+	*x = TrendingWord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendingWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingWord) ProtoMessage() {}
+
+func (x *TrendingWord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingWord.ProtoReflect.Descriptor instead.
+func (*TrendingWord) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TrendingWord) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TrendingWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *TrendingWord) GetRecentCount() int64 {
+	if x != nil {
+		return x.RecentCount
+	}
+	return 0
+}
+
+func (x *TrendingWord) GetBaselineAverage() float64 {
+	if x != nil {
+		return x.BaselineAverage
+	}
+	return 0
+}
+
+func (x *TrendingWord) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingWord) GetLastUsed() string {
+	if x != nil {
+		return x.LastUsed
+	}
+	return ""
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope ExportScope `protobuf:"varint,1,opt,name=scope,proto3,enum=makedonia_eukleides.ExportScope" json:"scope,omitempty"`
+	// optional, empty means all services
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// optional RFC3339 time; only counters used at or after it are exported
+	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportRequest) GetScope() ExportScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ExportScope_EXPORT_ALL
+}
+
+func (x *ExportRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ExportRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+// ExportedCounter is one global or session counter with everything Eukleides knows about it.
+type ExportedCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "global" or "session"
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// empty for global counters
+	SessionId   string           `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ServiceName string           `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Word        string           `protobuf:"bytes,4,opt,name=word,proto3" json:"word,omitempty"`
+	Count       int64            `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	LastUsed    string           `protobuf:"bytes,6,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	Hits        int64            `protobuf:"varint,7,opt,name=hits,proto3" json:"hits,omitempty"`
+	ZeroResults int64            `protobuf:"varint,8,opt,name=zero_results,json=zeroResults,proto3" json:"zero_results,omitempty"`
+	SearchTypes map[string]int64 `protobuf:"bytes,9,rep,name=search_types,json=searchTypes,proto3" json:"search_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Languages   map[string]int64 `protobuf:"bytes,10,rep,name=languages,proto3" json:"languages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ExportedCounter) Reset() {
+	*x = ExportedCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportedCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedCounter) ProtoMessage() {}
+
+func (x *ExportedCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedCounter.ProtoReflect.Descriptor instead.
+func (*ExportedCounter) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportedCounter) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ExportedCounter) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ExportedCounter) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ExportedCounter) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *ExportedCounter) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExportedCounter) GetLastUsed() string {
+	if x != nil {
+		return x.LastUsed
+	}
+	return ""
+}
+
+func (x *ExportedCounter) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *ExportedCounter) GetZeroResults() int64 {
+	if x != nil {
+		return x.ZeroResults
+	}
+	return 0
+}
+
+func (x *ExportedCounter) GetSearchTypes() map[string]int64 {
+	if x != nil {
+		return x.SearchTypes
+	}
+	return nil
+}
+
+func (x *ExportedCounter) GetLanguages() map[string]int64 {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type TopFiveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopFive []*TopFive `protobuf:"bytes,1,rep,name=top_five,json=topFive,proto3" json:"top_five,omitempty"`
+}
+
+func (x *TopFiveResponse) Reset() {
+	*x = TopFiveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopFiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopFiveResponse) ProtoMessage() {}
+
+func (x *TopFiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopFiveResponse.ProtoReflect.Descriptor instead.
+func (*TopFiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TopFiveResponse) GetTopFive() []*TopFive {
+	if x != nil {
+		return x.TopFive
+	}
+	return nil
+}
+
+type TopFive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Word        string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	LastUsed    string `protobuf:"bytes,3,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	Count       int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TopFive) Reset() {
+	*x = TopFive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopFive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopFive) ProtoMessage() {}
+
+func (x *TopFive) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopFive.ProtoReflect.Descriptor instead.
+func (*TopFive) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TopFive) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TopFive) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *TopFive) GetLastUsed() string {
+	if x != nil {
+		return x.LastUsed
+	}
+	return ""
+}
+
+func (x *TopFive) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ForgetSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the id as sent in the session header; it is hashed the same way as on ingestion
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *ForgetSessionRequest) Reset() {
+	*x = ForgetSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetSessionRequest) ProtoMessage() {}
+
+func (x *ForgetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetSessionRequest.ProtoReflect.Descriptor instead.
+func (*ForgetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ForgetSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ForgetSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed int64 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *ForgetSessionResponse) Reset() {
+	*x = ForgetSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetSessionResponse) ProtoMessage() {}
+
+func (x *ForgetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetSessionResponse.ProtoReflect.Descriptor instead.
+func (*ForgetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ForgetSessionResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type HealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Healthy  bool          `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Time     string        `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Version  string        `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Sessions *SessionStats `protobuf:"bytes,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HealthResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *HealthResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetSessions() *SessionStats {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// SessionStats reports the per-session counters held in memory and how many were evicted since start.
+type SessionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active          int64 `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	EvictedIdle     int64 `protobuf:"varint,2,opt,name=evicted_idle,json=evictedIdle,proto3" json:"evicted_idle,omitempty"`
+	EvictedCapacity int64 `protobuf:"varint,3,opt,name=evicted_capacity,json=evictedCapacity,proto3" json:"evicted_capacity,omitempty"`
+	Expired         int64 `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	Forgotten       int64 `protobuf:"varint,5,opt,name=forgotten,proto3" json:"forgotten,omitempty"`
+}
+
+func (x *SessionStats) Reset() {
+	*x = SessionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStats) ProtoMessage() {}
+
+func (x *SessionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStats.ProtoReflect.Descriptor instead.
+func (*SessionStats) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SessionStats) GetActive() int64 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *SessionStats) GetEvictedIdle() int64 {
+	if x != nil {
+		return x.EvictedIdle
+	}
+	return 0
+}
+
+func (x *SessionStats) GetEvictedCapacity() int64 {
+	if x != nil {
+		return x.EvictedCapacity
+	}
+	return 0
+}
+
+func (x *SessionStats) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *SessionStats) GetForgotten() int64 {
+	if x != nil {
+		return x.Forgotten
+	}
+	return 0
+}
+
+type HealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eukleides_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eukleides_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eukleides_proto_rawDescGZIP(), []int{22}
+}
+
+var File_proto_eukleides_proto protoreflect.FileDescriptor
+
+var file_proto_eukleides_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e,
+	0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x17,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64,
+	0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x13,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22,
+	0x44, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x6b,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x46, 0x69,
+	0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x14,
+	0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61,
+	0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a,
+	0x0a, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a,
+	0x0b, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69,
+	0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x5a, 0x65, 0x72,
+	0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x9c, 0x01, 0x0a,
+	0x0f, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b,
+	0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x10, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75,
+	0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xc6,
+	0x01, 0x0a, 0x0c, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x6f, 0x72, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64,
+	0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x92, 0x04, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x7a, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x61, 0x6b,
+	0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x51,
+	0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75,
+	0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x4a, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x69, 0x76, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61,
+	0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46, 0x69,
+	0x76, 0x65, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x22, 0x73, 0x0a, 0x07, 0x54,
+	0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x35, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x67, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f,
+	0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x6c, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x76, 0x69, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x74,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x67, 0x6f, 0x74,
+	0x74, 0x65, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2a, 0x4e, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
+	0x41, 0x53, 0x54, 0x5f, 0x32, 0x34, 0x48, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x41, 0x53,
+	0x54, 0x5f, 0x37, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33,
+	0x30, 0x44, 0x10, 0x04, 0x2a, 0x44, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x47, 0x4c,
+	0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x32, 0xc0, 0x09, 0x0a, 0x09, 0x45,
+	0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x6b,
+	0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64,
+	0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x6c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65,
+	0x77, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2c, 0x2e, 0x6d,
+	0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x6b,
+	0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x6b, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x5e, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x6f,
+	0x70, 0x46, 0x69, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69,
 	0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46,
 	0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x6b,
 	0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73,
@@ -612,16 +1828,53 @@ var file_proto_eukleides_proto_rawDesc = []byte{
 	0x69, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75,
 	0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75,
-	0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69,
-	0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x64, 0x79, 0x73, 0x73,
-	0x65, 0x69, 0x61, 0x2d, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x2f, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f,
-	0x6e, 0x69, 0x61, 0x2f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x29, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b,
+	0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61,
+	0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65,
+	0x73, 0x2e, 0x54, 0x6f, 0x70, 0x46, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54,
+	0x6f, 0x70, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65,
+	0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f,
+	0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x5a, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x27,
+	0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65,
+	0x69, 0x64, 0x65, 0x73, 0x2e, 0x5a, 0x65, 0x72, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f,
+	0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x24, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c,
+	0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69,
+	0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x22, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b,
+	0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61,
+	0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x00, 0x30, 0x01, 0x12, 0x68,
+	0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c,
+	0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x61, 0x6b,
+	0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73,
+	0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x5f, 0x65,
+	0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e,
+	0x69, 0x61, 0x5f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a,
+	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x64, 0x79, 0x73,
+	0x73, 0x65, 0x69, 0x61, 0x2d, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x2f, 0x6d, 0x61, 0x6b, 0x65, 0x64,
+	0x6f, 0x6e, 0x69, 0x61, 0x2f, 0x65, 0x75, 0x6b, 0x6c, 0x65, 0x69, 0x64, 0x65, 0x73, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -636,37 +1889,77 @@ func file_proto_eukleides_proto_rawDescGZIP() []byte {
 	return file_proto_eukleides_proto_rawDescData
 }
 
-var file_proto_eukleides_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_eukleides_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_eukleides_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_proto_eukleides_proto_goTypes = []interface{}{
-	(*CountCreationRequestSet)(nil), // 0: makedonia_eukleides.CountCreationRequestSet
-	(*CountCreationRequest)(nil),    // 1: makedonia_eukleides.CountCreationRequest
-	(*CountStreamResponse)(nil),     // 2: makedonia_eukleides.CountStreamResponse
-	(*TopFiveRequest)(nil),          // 3: makedonia_eukleides.TopFiveRequest
-	(*TopFiveServiceRequest)(nil),   // 4: makedonia_eukleides.TopFiveServiceRequest
-	(*TopFiveSessionRequest)(nil),   // 5: makedonia_eukleides.TopFiveSessionRequest
-	(*TopFiveResponse)(nil),         // 6: makedonia_eukleides.TopFiveResponse
-	(*TopFive)(nil),                 // 7: makedonia_eukleides.TopFive
-	(*HealthResponse)(nil),          // 8: makedonia_eukleides.HealthResponse
-	(*HealthRequest)(nil),           // 9: makedonia_eukleides.HealthRequest
+	(Window)(0),                     // 0: makedonia_eukleides.Window
+	(ExportScope)(0),                // 1: makedonia_eukleides.ExportScope
+	(*CountCreationRequestSet)(nil), // 2: makedonia_eukleides.CountCreationRequestSet
+	(*CountCreationRequest)(nil),    // 3: makedonia_eukleides.CountCreationRequest
+	(*CountStreamResponse)(nil),     // 4: makedonia_eukleides.CountStreamResponse
+	(*CountBatchAck)(nil),           // 5: makedonia_eukleides.CountBatchAck
+	(*TopFiveRequest)(nil),          // 6: makedonia_eukleides.TopFiveRequest
+	(*TopFiveServiceRequest)(nil),   // 7: makedonia_eukleides.TopFiveServiceRequest
+	(*TopFiveSessionRequest)(nil),   // 8: makedonia_eukleides.TopFiveSessionRequest
+	(*TopFiveWindowRequest)(nil),    // 9: makedonia_eukleides.TopFiveWindowRequest
+	(*TopRequest)(nil),              // 10: makedonia_eukleides.TopRequest
+	(*TopResponse)(nil),             // 11: makedonia_eukleides.TopResponse
+	(*ZeroResultsRequest)(nil),      // 12: makedonia_eukleides.ZeroResultsRequest
+	(*TrendingRequest)(nil),         // 13: makedonia_eukleides.TrendingRequest
+	(*TrendingResponse)(nil),        // 14: makedonia_eukleides.TrendingResponse
+	(*TrendingWord)(nil),            // 15: makedonia_eukleides.TrendingWord
+	(*ExportRequest)(nil),           // 16: makedonia_eukleides.ExportRequest
+	(*ExportedCounter)(nil),         // 17: makedonia_eukleides.ExportedCounter
+	(*TopFiveResponse)(nil),         // 18: makedonia_eukleides.TopFiveResponse
+	(*TopFive)(nil),                 // 19: makedonia_eukleides.TopFive
+	(*ForgetSessionRequest)(nil),    // 20: makedonia_eukleides.ForgetSessionRequest
+	(*ForgetSessionResponse)(nil),   // 21: makedonia_eukleides.ForgetSessionResponse
+	(*HealthResponse)(nil),          // 22: makedonia_eukleides.HealthResponse
+	(*SessionStats)(nil),            // 23: makedonia_eukleides.SessionStats
+	(*HealthRequest)(nil),           // 24: makedonia_eukleides.HealthRequest
+	nil,                             // 25: makedonia_eukleides.ExportedCounter.SearchTypesEntry
+	nil,                             // 26: makedonia_eukleides.ExportedCounter.LanguagesEntry
 }
 var file_proto_eukleides_proto_depIdxs = []int32{
-	1, // 0: makedonia_eukleides.CountCreationRequestSet.request:type_name -> makedonia_eukleides.CountCreationRequest
-	7, // 1: makedonia_eukleides.TopFiveResponse.top_five:type_name -> makedonia_eukleides.TopFive
-	0, // 2: makedonia_eukleides.Eukleides.CreateNewEntry:input_type -> makedonia_eukleides.CountCreationRequestSet
-	3, // 3: makedonia_eukleides.Eukleides.RetrieveTopFive:input_type -> makedonia_eukleides.TopFiveRequest
-	4, // 4: makedonia_eukleides.Eukleides.RetrieveTopFiveService:input_type -> makedonia_eukleides.TopFiveServiceRequest
-	5, // 5: makedonia_eukleides.Eukleides.RetrieveTopFiveForSession:input_type -> makedonia_eukleides.TopFiveSessionRequest
-	9, // 6: makedonia_eukleides.Eukleides.Health:input_type -> makedonia_eukleides.HealthRequest
-	2, // 7: makedonia_eukleides.Eukleides.CreateNewEntry:output_type -> makedonia_eukleides.CountStreamResponse
-	6, // 8: makedonia_eukleides.Eukleides.RetrieveTopFive:output_type -> makedonia_eukleides.TopFiveResponse
-	7, // 9: makedonia_eukleides.Eukleides.RetrieveTopFiveService:output_type -> makedonia_eukleides.TopFive
-	6, // 10: makedonia_eukleides.Eukleides.RetrieveTopFiveForSession:output_type -> makedonia_eukleides.TopFiveResponse
-	8, // 11: makedonia_eukleides.Eukleides.Health:output_type -> makedonia_eukleides.HealthResponse
-	7, // [7:12] is the sub-list for method output_type
-	2, // [2:7] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3,  // 0: makedonia_eukleides.CountCreationRequestSet.request:type_name -> makedonia_eukleides.CountCreationRequest
+	0,  // 1: makedonia_eukleides.TopFiveWindowRequest.window:type_name -> makedonia_eukleides.Window
+	19, // 2: makedonia_eukleides.TopResponse.entries:type_name -> makedonia_eukleides.TopFive
+	0,  // 3: makedonia_eukleides.TrendingRequest.window:type_name -> makedonia_eukleides.Window
+	15, // 4: makedonia_eukleides.TrendingResponse.trending:type_name -> makedonia_eukleides.TrendingWord
+	1,  // 5: makedonia_eukleides.ExportRequest.scope:type_name -> makedonia_eukleides.ExportScope
+	25, // 6: makedonia_eukleides.ExportedCounter.search_types:type_name -> makedonia_eukleides.ExportedCounter.SearchTypesEntry
+	26, // 7: makedonia_eukleides.ExportedCounter.languages:type_name -> makedonia_eukleides.ExportedCounter.LanguagesEntry
+	19, // 8: makedonia_eukleides.TopFiveResponse.top_five:type_name -> makedonia_eukleides.TopFive
+	23, // 9: makedonia_eukleides.HealthResponse.sessions:type_name -> makedonia_eukleides.SessionStats
+	2,  // 10: makedonia_eukleides.Eukleides.CreateNewEntry:input_type -> makedonia_eukleides.CountCreationRequestSet
+	2,  // 11: makedonia_eukleides.Eukleides.CreateNewEntryStream:input_type -> makedonia_eukleides.CountCreationRequestSet
+	6,  // 12: makedonia_eukleides.Eukleides.RetrieveTopFive:input_type -> makedonia_eukleides.TopFiveRequest
+	7,  // 13: makedonia_eukleides.Eukleides.RetrieveTopFiveService:input_type -> makedonia_eukleides.TopFiveServiceRequest
+	8,  // 14: makedonia_eukleides.Eukleides.RetrieveTopFiveForSession:input_type -> makedonia_eukleides.TopFiveSessionRequest
+	9,  // 15: makedonia_eukleides.Eukleides.RetrieveTopFiveWindow:input_type -> makedonia_eukleides.TopFiveWindowRequest
+	10, // 16: makedonia_eukleides.Eukleides.RetrieveTop:input_type -> makedonia_eukleides.TopRequest
+	12, // 17: makedonia_eukleides.Eukleides.RetrieveZeroResults:input_type -> makedonia_eukleides.ZeroResultsRequest
+	13, // 18: makedonia_eukleides.Eukleides.RetrieveTrending:input_type -> makedonia_eukleides.TrendingRequest
+	16, // 19: makedonia_eukleides.Eukleides.ExportCounters:input_type -> makedonia_eukleides.ExportRequest
+	20, // 20: makedonia_eukleides.Eukleides.ForgetSession:input_type -> makedonia_eukleides.ForgetSessionRequest
+	24, // 21: makedonia_eukleides.Eukleides.Health:input_type -> makedonia_eukleides.HealthRequest
+	4,  // 22: makedonia_eukleides.Eukleides.CreateNewEntry:output_type -> makedonia_eukleides.CountStreamResponse
+	5,  // 23: makedonia_eukleides.Eukleides.CreateNewEntryStream:output_type -> makedonia_eukleides.CountBatchAck
+	18, // 24: makedonia_eukleides.Eukleides.RetrieveTopFive:output_type -> makedonia_eukleides.TopFiveResponse
+	19, // 25: makedonia_eukleides.Eukleides.RetrieveTopFiveService:output_type -> makedonia_eukleides.TopFive
+	18, // 26: makedonia_eukleides.Eukleides.RetrieveTopFiveForSession:output_type -> makedonia_eukleides.TopFiveResponse
+	18, // 27: makedonia_eukleides.Eukleides.RetrieveTopFiveWindow:output_type -> makedonia_eukleides.TopFiveResponse
+	11, // 28: makedonia_eukleides.Eukleides.RetrieveTop:output_type -> makedonia_eukleides.TopResponse
+	11, // 29: makedonia_eukleides.Eukleides.RetrieveZeroResults:output_type -> makedonia_eukleides.TopResponse
+	14, // 30: makedonia_eukleides.Eukleides.RetrieveTrending:output_type -> makedonia_eukleides.TrendingResponse
+	17, // 31: makedonia_eukleides.Eukleides.ExportCounters:output_type -> makedonia_eukleides.ExportedCounter
+	21, // 32: makedonia_eukleides.Eukleides.ForgetSession:output_type -> makedonia_eukleides.ForgetSessionResponse
+	22, // 33: makedonia_eukleides.Eukleides.Health:output_type -> makedonia_eukleides.HealthResponse
+	22, // [22:34] is the sub-list for method output_type
+	10, // [10:22] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_proto_eukleides_proto_init() }
@@ -712,7 +2005,7 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopFiveRequest); i {
+			switch v := v.(*CountBatchAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -724,7 +2017,7 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopFiveServiceRequest); i {
+			switch v := v.(*TopFiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -736,7 +2029,7 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopFiveSessionRequest); i {
+			switch v := v.(*TopFiveServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -748,7 +2041,7 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopFiveResponse); i {
+			switch v := v.(*TopFiveSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -760,7 +2053,7 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopFive); i {
+			switch v := v.(*TopFiveWindowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -772,7 +2065,7 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthResponse); i {
+			switch v := v.(*TopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -784,6 +2077,162 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 		file_proto_eukleides_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZeroResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrendingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrendingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrendingWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportedCounter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopFiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopFive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgetSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eukleides_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthRequest); i {
 			case 0:
 				return &v.state
@@ -796,18 +2245,20 @@ func file_proto_eukleides_proto_init() {
 			}
 		}
 	}
+	file_proto_eukleides_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_eukleides_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_eukleides_proto_goTypes,
 		DependencyIndexes: file_proto_eukleides_proto_depIdxs,
+		EnumInfos:         file_proto_eukleides_proto_enumTypes,
 		MessageInfos:      file_proto_eukleides_proto_msgTypes,
 	}.Build()
 	File_proto_eukleides_proto = out.File
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
-// - protoc             v6.32.1
+// - protoc             (unknown)
 // source: proto/eukleides.proto
 
 package proto
@@ -23,9 +23,18 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EukleidesClient interface {
 	CreateNewEntry(ctx context.Context, opts ...grpc.CallOption) (Eukleides_CreateNewEntryClient, error)
+	// CreateNewEntryStream acks every batch by its batch_id once it has been applied, in the order the batches were sent.
+	CreateNewEntryStream(ctx context.Context, opts ...grpc.CallOption) (Eukleides_CreateNewEntryStreamClient, error)
 	RetrieveTopFive(ctx context.Context, in *TopFiveRequest, opts ...grpc.CallOption) (*TopFiveResponse, error)
 	RetrieveTopFiveService(ctx context.Context, in *TopFiveServiceRequest, opts ...grpc.CallOption) (*TopFive, error)
 	RetrieveTopFiveForSession(ctx context.Context, in *TopFiveSessionRequest, opts ...grpc.CallOption) (*TopFiveResponse, error)
+	RetrieveTopFiveWindow(ctx context.Context, in *TopFiveWindowRequest, opts ...grpc.CallOption) (*TopFiveResponse, error)
+	RetrieveTop(ctx context.Context, in *TopRequest, opts ...grpc.CallOption) (*TopResponse, error)
+	RetrieveZeroResults(ctx context.Context, in *ZeroResultsRequest, opts ...grpc.CallOption) (*TopResponse, error)
+	RetrieveTrending(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*TrendingResponse, error)
+	ExportCounters(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Eukleides_ExportCountersClient, error)
+	// ForgetSession erases every counter of a session; global aggregates are kept.
+	ForgetSession(ctx context.Context, in *ForgetSessionRequest, opts ...grpc.CallOption) (*ForgetSessionResponse, error)
 	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
@@ -71,6 +80,37 @@ func (x *eukleidesCreateNewEntryClient) CloseAndRecv() (*CountStreamResponse, er
 	return m, nil
 }
 
+func (c *eukleidesClient) CreateNewEntryStream(ctx context.Context, opts ...grpc.CallOption) (Eukleides_CreateNewEntryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Eukleides_ServiceDesc.Streams[1], "/makedonia_eukleides.Eukleides/CreateNewEntryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eukleidesCreateNewEntryStreamClient{stream}
+	return x, nil
+}
+
+type Eukleides_CreateNewEntryStreamClient interface {
+	Send(*CountCreationRequestSet) error
+	Recv() (*CountBatchAck, error)
+	grpc.ClientStream
+}
+
+type eukleidesCreateNewEntryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *eukleidesCreateNewEntryStreamClient) Send(m *CountCreationRequestSet) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *eukleidesCreateNewEntryStreamClient) Recv() (*CountBatchAck, error) {
+	m := new(CountBatchAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *eukleidesClient) RetrieveTopFive(ctx context.Context, in *TopFiveRequest, opts ...grpc.CallOption) (*TopFiveResponse, error) {
 	out := new(TopFiveResponse)
 	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/RetrieveTopFive", in, out, opts...)
@@ -98,6 +138,83 @@ func (c *eukleidesClient) RetrieveTopFiveForSession(ctx context.Context, in *Top
 	return out, nil
 }
 
+func (c *eukleidesClient) RetrieveTopFiveWindow(ctx context.Context, in *TopFiveWindowRequest, opts ...grpc.CallOption) (*TopFiveResponse, error) {
+	out := new(TopFiveResponse)
+	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/RetrieveTopFiveWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eukleidesClient) RetrieveTop(ctx context.Context, in *TopRequest, opts ...grpc.CallOption) (*TopResponse, error) {
+	out := new(TopResponse)
+	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/RetrieveTop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eukleidesClient) RetrieveZeroResults(ctx context.Context, in *ZeroResultsRequest, opts ...grpc.CallOption) (*TopResponse, error) {
+	out := new(TopResponse)
+	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/RetrieveZeroResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eukleidesClient) RetrieveTrending(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*TrendingResponse, error) {
+	out := new(TrendingResponse)
+	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/RetrieveTrending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eukleidesClient) ExportCounters(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Eukleides_ExportCountersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Eukleides_ServiceDesc.Streams[2], "/makedonia_eukleides.Eukleides/ExportCounters", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eukleidesExportCountersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Eukleides_ExportCountersClient interface {
+	Recv() (*ExportedCounter, error)
+	grpc.ClientStream
+}
+
+type eukleidesExportCountersClient struct {
+	grpc.ClientStream
+}
+
+func (x *eukleidesExportCountersClient) Recv() (*ExportedCounter, error) {
+	m := new(ExportedCounter)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eukleidesClient) ForgetSession(ctx context.Context, in *ForgetSessionRequest, opts ...grpc.CallOption) (*ForgetSessionResponse, error) {
+	out := new(ForgetSessionResponse)
+	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/ForgetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eukleidesClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
 	out := new(HealthResponse)
 	err := c.cc.Invoke(ctx, "/makedonia_eukleides.Eukleides/Health", in, out, opts...)
@@ -112,9 +229,18 @@ func (c *eukleidesClient) Health(ctx context.Context, in *HealthRequest, opts ..
 // for forward compatibility
 type EukleidesServer interface {
 	CreateNewEntry(Eukleides_CreateNewEntryServer) error
+	// CreateNewEntryStream acks every batch by its batch_id once it has been applied, in the order the batches were sent.
+	CreateNewEntryStream(Eukleides_CreateNewEntryStreamServer) error
 	RetrieveTopFive(context.Context, *TopFiveRequest) (*TopFiveResponse, error)
 	RetrieveTopFiveService(context.Context, *TopFiveServiceRequest) (*TopFive, error)
 	RetrieveTopFiveForSession(context.Context, *TopFiveSessionRequest) (*TopFiveResponse, error)
+	RetrieveTopFiveWindow(context.Context, *TopFiveWindowRequest) (*TopFiveResponse, error)
+	RetrieveTop(context.Context, *TopRequest) (*TopResponse, error)
+	RetrieveZeroResults(context.Context, *ZeroResultsRequest) (*TopResponse, error)
+	RetrieveTrending(context.Context, *TrendingRequest) (*TrendingResponse, error)
+	ExportCounters(*ExportRequest, Eukleides_ExportCountersServer) error
+	// ForgetSession erases every counter of a session; global aggregates are kept.
+	ForgetSession(context.Context, *ForgetSessionRequest) (*ForgetSessionResponse, error)
 	Health(context.Context, *HealthRequest) (*HealthResponse, error)
 	mustEmbedUnimplementedEukleidesServer()
 }
@@ -126,6 +252,9 @@ type UnimplementedEukleidesServer struct {
 func (UnimplementedEukleidesServer) CreateNewEntry(Eukleides_CreateNewEntryServer) error {
 	return status.Errorf(codes.Unimplemented, "method CreateNewEntry not implemented")
 }
+func (UnimplementedEukleidesServer) CreateNewEntryStream(Eukleides_CreateNewEntryStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateNewEntryStream not implemented")
+}
 func (UnimplementedEukleidesServer) RetrieveTopFive(context.Context, *TopFiveRequest) (*TopFiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveTopFive not implemented")
 }
@@ -135,6 +264,24 @@ func (UnimplementedEukleidesServer) RetrieveTopFiveService(context.Context, *Top
 func (UnimplementedEukleidesServer) RetrieveTopFiveForSession(context.Context, *TopFiveSessionRequest) (*TopFiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveTopFiveForSession not implemented")
 }
+func (UnimplementedEukleidesServer) RetrieveTopFiveWindow(context.Context, *TopFiveWindowRequest) (*TopFiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveTopFiveWindow not implemented")
+}
+func (UnimplementedEukleidesServer) RetrieveTop(context.Context, *TopRequest) (*TopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveTop not implemented")
+}
+func (UnimplementedEukleidesServer) RetrieveZeroResults(context.Context, *ZeroResultsRequest) (*TopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveZeroResults not implemented")
+}
+func (UnimplementedEukleidesServer) RetrieveTrending(context.Context, *TrendingRequest) (*TrendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveTrending not implemented")
+}
+func (UnimplementedEukleidesServer) ExportCounters(*ExportRequest, Eukleides_ExportCountersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCounters not implemented")
+}
+func (UnimplementedEukleidesServer) ForgetSession(context.Context, *ForgetSessionRequest) (*ForgetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetSession not implemented")
+}
 func (UnimplementedEukleidesServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
@@ -177,6 +324,32 @@ func (x *eukleidesCreateNewEntryServer) Recv() (*CountCreationRequestSet, error)
 	return m, nil
 }
 
+func _Eukleides_CreateNewEntryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EukleidesServer).CreateNewEntryStream(&eukleidesCreateNewEntryStreamServer{stream})
+}
+
+type Eukleides_CreateNewEntryStreamServer interface {
+	Send(*CountBatchAck) error
+	Recv() (*CountCreationRequestSet, error)
+	grpc.ServerStream
+}
+
+type eukleidesCreateNewEntryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *eukleidesCreateNewEntryStreamServer) Send(m *CountBatchAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *eukleidesCreateNewEntryStreamServer) Recv() (*CountCreationRequestSet, error) {
+	m := new(CountCreationRequestSet)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Eukleides_RetrieveTopFive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TopFiveRequest)
 	if err := dec(in); err != nil {
@@ -231,6 +404,117 @@ func _Eukleides_RetrieveTopFiveForSession_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Eukleides_RetrieveTopFiveWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopFiveWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EukleidesServer).RetrieveTopFiveWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/makedonia_eukleides.Eukleides/RetrieveTopFiveWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EukleidesServer).RetrieveTopFiveWindow(ctx, req.(*TopFiveWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Eukleides_RetrieveTop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EukleidesServer).RetrieveTop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/makedonia_eukleides.Eukleides/RetrieveTop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EukleidesServer).RetrieveTop(ctx, req.(*TopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Eukleides_RetrieveZeroResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZeroResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EukleidesServer).RetrieveZeroResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/makedonia_eukleides.Eukleides/RetrieveZeroResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EukleidesServer).RetrieveZeroResults(ctx, req.(*ZeroResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Eukleides_RetrieveTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EukleidesServer).RetrieveTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/makedonia_eukleides.Eukleides/RetrieveTrending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EukleidesServer).RetrieveTrending(ctx, req.(*TrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Eukleides_ExportCounters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EukleidesServer).ExportCounters(m, &eukleidesExportCountersServer{stream})
+}
+
+type Eukleides_ExportCountersServer interface {
+	Send(*ExportedCounter) error
+	grpc.ServerStream
+}
+
+type eukleidesExportCountersServer struct {
+	grpc.ServerStream
+}
+
+func (x *eukleidesExportCountersServer) Send(m *ExportedCounter) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Eukleides_ForgetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EukleidesServer).ForgetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/makedonia_eukleides.Eukleides/ForgetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EukleidesServer).ForgetSession(ctx, req.(*ForgetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Eukleides_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthRequest)
 	if err := dec(in); err != nil {
@@ -268,6 +552,26 @@ var Eukleides_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveTopFiveForSession",
 			Handler:    _Eukleides_RetrieveTopFiveForSession_Handler,
 		},
+		{
+			MethodName: "RetrieveTopFiveWindow",
+			Handler:    _Eukleides_RetrieveTopFiveWindow_Handler,
+		},
+		{
+			MethodName: "RetrieveTop",
+			Handler:    _Eukleides_RetrieveTop_Handler,
+		},
+		{
+			MethodName: "RetrieveZeroResults",
+			Handler:    _Eukleides_RetrieveZeroResults_Handler,
+		},
+		{
+			MethodName: "RetrieveTrending",
+			Handler:    _Eukleides_RetrieveTrending_Handler,
+		},
+		{
+			MethodName: "ForgetSession",
+			Handler:    _Eukleides_ForgetSession_Handler,
+		},
 		{
 			MethodName: "Health",
 			Handler:    _Eukleides_Health_Handler,
@@ -279,6 +583,17 @@ var Eukleides_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Eukleides_CreateNewEntry_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CreateNewEntryStream",
+			Handler:       _Eukleides_CreateNewEntryStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCounters",
+			Handler:       _Eukleides_ExportCounters_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/eukleides.proto",
 }
@@ -81,7 +81,9 @@ type SearchQuery struct {
 
 	Word            string   `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`                                  // e.g., "Ἀθηναῖος"
 	Language        Language `protobuf:"varint,2,opt,name=language,proto3,enum=koinos.v1.Language" json:"language,omitempty"` // e.g., LANG_GREEK
-	NumberOfResults int32    `protobuf:"varint,3,opt,name=numberOfResults,proto3" json:"numberOfResults,omitempty"`           // e.g., 3
+	NumberOfResults int32    `protobuf:"varint,3,opt,name=numberOfResults,proto3" json:"numberOfResults,omitempty"`           // e.g., 3, the page size
+	Page            int32    `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                 // 1-based, 0 means the first page
+	SearchAfter     string   `protobuf:"bytes,5,opt,name=search_after,json=searchAfter,proto3" json:"search_after,omitempty"` // PageInfo.next_cursor of the previous page, takes precedence over page
 }
 
 func (x *SearchQuery) Reset() {
@@ -137,36 +139,54 @@ func (x *SearchQuery) GetNumberOfResults() int32 {
 	return 0
 }
 
+func (x *SearchQuery) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchQuery) GetSearchAfter() string {
+	if x != nil {
+		return x.SearchAfter
+	}
+	return ""
+}
+
 var File_koinos_v1_search_proto protoreflect.FileDescriptor
 
 var file_koinos_v1_search_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72,
 	0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73,
-	0x2e, 0x76, 0x31, 0x22, 0x7c, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x6f, 0x69, 0x6e, 0x6f,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x2a, 0x56, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
-	0x14, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x4e, 0x47, 0x5f,
-	0x47, 0x52, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x4e, 0x47, 0x5f,
-	0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x4e,
-	0x47, 0x5f, 0x44, 0x55, 0x54, 0x43, 0x48, 0x10, 0x03, 0x42, 0xa9, 0x01, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x64, 0x79, 0x73, 0x73, 0x65, 0x69, 0x61, 0x2d,
-	0x67, 0x72, 0x65, 0x65, 0x6b, 0x2f, 0x6d, 0x61, 0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x2f,
-	0x66, 0x69, 0x6c, 0x69, 0x70, 0x70, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
-	0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x4b, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x4b, 0x6f, 0x69, 0x6e, 0x6f,
-	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4b, 0x6f, 0x69, 0x6e, 0x6f,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x6f, 0x69, 0x6e,
+	0x6f, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x41, 0x66, 0x74, 0x65, 0x72, 0x2a, 0x56, 0x0a, 0x08, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x4e, 0x47, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x4e, 0x47, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x4e, 0x47, 0x5f, 0x44, 0x55, 0x54, 0x43, 0x48, 0x10,
+	0x03, 0x42, 0xa9, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
+	0x64, 0x79, 0x73, 0x73, 0x65, 0x69, 0x61, 0x2d, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x2f, 0x6d, 0x61,
+	0x6b, 0x65, 0x64, 0x6f, 0x6e, 0x69, 0x61, 0x2f, 0x66, 0x69, 0x6c, 0x69, 0x70, 0x70, 0x6f, 0x73,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x2f, 0x76,
+	0x31, 0x3b, 0x6b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4b, 0x58, 0x58,
+	0xaa, 0x02, 0x09, 0x4b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x4b,
+	0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x69, 0x6e, 0x6f,
+	0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0a, 0x4b, 0x6f, 0x69, 0x6e, 0x6f, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
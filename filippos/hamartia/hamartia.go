@@ -0,0 +1,134 @@
+// Package hamartia is the error catalogue shared by the makedonia services and alexandros. Services answer with a
+// gRPC status carrying an ErrorInfo reason from this catalogue; alexandros turns the status code into a stable
+// extensions.code clients can branch on.
+package hamartia
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/odysseia-greek/agora/plato/logging"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is set on every ErrorInfo so reasons from makedonia are not confused with those of other systems.
+const Domain = "makedonia.odysseia-greek"
+
+// Code is the value of extensions.code in a GraphQL error.
+type Code string
+
+const (
+	CodeBadUserInput       Code = "BAD_USER_INPUT"
+	CodeNotFound           Code = "NOT_FOUND"
+	CodeServiceUnavailable Code = "SERVICE_UNAVAILABLE"
+	CodeTimeout            Code = "TIMEOUT"
+	CodeRateLimited        Code = "RATE_LIMITED"
+	CodeUnauthenticated    Code = "UNAUTHENTICATED"
+	CodeForbidden          Code = "FORBIDDEN"
+	CodeInternal           Code = "INTERNAL_SERVER_ERROR"
+)
+
+// Reasons tell apart errors that share a gRPC code.
+const (
+	ReasonUnsupportedLanguage = "UNSUPPORTED_LANGUAGE"
+	ReasonInvalidPage         = "INVALID_PAGE"
+	ReasonInvalidArgument     = "INVALID_ARGUMENT"
+	ReasonSearchBackend       = "SEARCH_BACKEND_UNAVAILABLE"
+	ReasonTextBackend         = "TEXT_BACKEND_UNAVAILABLE"
+	ReasonMalformedDocument   = "MALFORMED_DOCUMENT"
+	ReasonNotFound            = "NOT_FOUND"
+	ReasonDeadline            = "DEADLINE_EXCEEDED"
+	ReasonOperationNotAllowed = "OPERATION_NOT_ALLOWED"
+	ReasonShuttingDown        = "SHUTTING_DOWN"
+	ReasonStorage             = "STORAGE_UNAVAILABLE"
+)
+
+var grpcToCode = map[codes.Code]Code{
+	codes.InvalidArgument:    CodeBadUserInput,
+	codes.OutOfRange:         CodeBadUserInput,
+	codes.FailedPrecondition: CodeBadUserInput,
+	codes.NotFound:           CodeNotFound,
+	codes.Unavailable:        CodeServiceUnavailable,
+	codes.DeadlineExceeded:   CodeTimeout,
+	codes.Canceled:           CodeTimeout,
+	codes.ResourceExhausted:  CodeRateLimited,
+	codes.Unauthenticated:    CodeUnauthenticated,
+	codes.PermissionDenied:   CodeForbidden,
+}
+
+// CodeFor maps a gRPC code onto the catalogue, anything not listed is an internal error.
+func CodeFor(code codes.Code) Code {
+	if c, ok := grpcToCode[code]; ok {
+		return c
+	}
+	return CodeInternal
+}
+
+// New returns a status error with an ErrorInfo detail holding reason and metadata.
+func New(code codes.Code, reason string, metadata map[string]string, format string, args ...interface{}) error {
+	st := status.New(code, fmt.Sprintf(format, args...))
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// UnsupportedLanguage is the InvalidArgument returned for a language a service has no field for.
+func UnsupportedLanguage(language fmt.Stringer) error {
+	return New(codes.InvalidArgument, ReasonUnsupportedLanguage, map[string]string{"language": language.String()}, "unsupported language: %s", language)
+}
+
+// InvalidArgument rejects a request the caller has to change before retrying.
+func InvalidArgument(reason string, err error) error {
+	return New(codes.InvalidArgument, reason, nil, "%s", err.Error())
+}
+
+// NotFound tells the caller there is nothing under the key it asked for.
+func NotFound(reason string, metadata map[string]string, format string, args ...interface{}) error {
+	return New(codes.NotFound, reason, metadata, format, args...)
+}
+
+// Unavailable tells the caller this service cannot take the request right now, but another replica or a retry may.
+func Unavailable(reason string, format string, args ...interface{}) error {
+	return New(codes.Unavailable, reason, nil, format, args...)
+}
+
+// Backend reports a failed call to a store or service behind this one. A caller whose deadline passed gets
+// DeadlineExceeded, anyone else Unavailable, so they know a retry may work. The cause is logged, not returned, it
+// names hosts and indices the caller has no business seeing.
+func Backend(ctx context.Context, reason string, err error) error {
+	logging.Error(fmt.Sprintf("%s: %s", reason, err.Error()))
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return New(codes.DeadlineExceeded, ReasonDeadline, nil, "deadline passed while waiting on the backend")
+	}
+	return New(codes.Unavailable, reason, nil, "the backend is unavailable, try again later")
+}
+
+// Internal reports a failure the caller cannot do anything about. Like Backend it logs the cause and returns a fixed
+// message.
+func Internal(reason string, err error) error {
+	logging.Error(fmt.Sprintf("%s: %s", reason, err.Error()))
+	return New(codes.Internal, reason, nil, "internal error")
+}
+
+// Reason returns the ErrorInfo reason and metadata of a status error, if it has any.
+func Reason(err error) (string, map[string]string) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return "", nil
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason, info.Metadata
+		}
+	}
+	return "", nil
+}
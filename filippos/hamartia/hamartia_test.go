@@ -0,0 +1,67 @@
+package hamartia
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCatalogue(t *testing.T) {
+	t.Run("CarriesReasonAndMetadata", func(t *testing.T) {
+		err := UnsupportedLanguage(koinos.Language_LANGUAGE_UNSPECIFIED)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		reason, metadata := Reason(err)
+		assert.Equal(t, ReasonUnsupportedLanguage, reason)
+		assert.Equal(t, "LANGUAGE_UNSPECIFIED", metadata["language"])
+	})
+
+	t.Run("ReasonSurvivesWrapping", func(t *testing.T) {
+		err := fmt.Errorf("hefaistion: %w", InvalidArgument(ReasonInvalidPage, errors.New("page must not be negative")))
+		reason, _ := Reason(err)
+		assert.Equal(t, ReasonInvalidPage, reason)
+		assert.Equal(t, CodeBadUserInput, CodeFor(status.Code(err)))
+	})
+
+	t.Run("BackendTellsTimeoutFromOutage", func(t *testing.T) {
+		err := Backend(context.Background(), ReasonSearchBackend, errors.New("connection refused"))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.NotContains(t, status.Convert(err).Message(), "connection refused")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+		<-ctx.Done()
+		err = Backend(ctx, ReasonSearchBackend, errors.New("connection refused"))
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+
+	t.Run("InternalHidesTheCause", func(t *testing.T) {
+		err := Internal(ReasonMalformedDocument, errors.New("invalid character '<' looking for beginning of value"))
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, "internal error", status.Convert(err).Message())
+
+		reason, _ := Reason(err)
+		assert.Equal(t, ReasonMalformedDocument, reason)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		err := NotFound(ReasonNotFound, map[string]string{"word": "λόγος"}, "no texts found for %s", "λόγος")
+		assert.Equal(t, CodeNotFound, CodeFor(status.Code(err)))
+
+		reason, metadata := Reason(err)
+		assert.Equal(t, ReasonNotFound, reason)
+		assert.Equal(t, "λόγος", metadata["word"])
+	})
+
+	t.Run("UnknownCodesAreInternal", func(t *testing.T) {
+		assert.Equal(t, CodeInternal, CodeFor(codes.DataLoss))
+		assert.Equal(t, CodeNotFound, CodeFor(codes.NotFound))
+		assert.Equal(t, CodeTimeout, CodeFor(codes.DeadlineExceeded))
+	})
+}
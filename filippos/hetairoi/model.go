@@ -27,7 +27,7 @@ type Verb struct {
 }
 
 type LemmaSource struct {
-	ID           string             `json:"id,omitempty"`         // if you store one
+	ID           string             `json:"id,omitempty"`         // set by demokritos, the paging tie-breaker
 	Greek        string             `json:"greek"`                // "λόγος"
 	Normalized   string             `json:"normalized,omitempty"` // "λογος"
 	LinkedWord   string             `json:"linkedWord,omitempty"`
@@ -0,0 +1,200 @@
+package hetairoi
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+)
+
+const (
+	DefaultPageSize int32 = 5
+	MaxPageSize     int32 = 100
+	// maxResultWindow mirrors the elastic index.max_result_window, pages past it need a search_after cursor
+	maxResultWindow int32 = 10000
+)
+
+// TieBreaker is the keyword field holding the unique id demokritos gives every lemma. Hits with an equal score are
+// ordered by it, so a cursor resumes at the same place however the shards answer.
+const TieBreaker = "id"
+
+// ErrInvalidPage is wrapped by every error PageFromQuery returns, so services can answer with InvalidArgument.
+var ErrInvalidPage = errors.New("invalid page")
+
+// Page is the slice of hits a SearchQuery asks for, either by page number or by the cursor of the previous page.
+type Page struct {
+	Number int32
+	Size   int32
+	After  []interface{}
+}
+
+// PageFromQuery reads the page from a SearchQuery, where numberOfResults is the page size.
+func PageFromQuery(request *koinos.SearchQuery) (*Page, error) {
+	page := &Page{
+		Number: request.Page,
+		Size:   request.NumberOfResults,
+	}
+
+	if page.Size < 0 || page.Number < 0 {
+		return nil, fmt.Errorf("%w: page and numberOfResults must not be negative", ErrInvalidPage)
+	}
+	if page.Size == 0 {
+		page.Size = DefaultPageSize
+	}
+	if page.Size > MaxPageSize {
+		page.Size = MaxPageSize
+	}
+	if page.Number == 0 {
+		page.Number = 1
+	}
+
+	if request.SearchAfter != "" {
+		after, err := decodeCursor(request.SearchAfter)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed search_after cursor", ErrInvalidPage)
+		}
+		page.After = after
+		return page, nil
+	}
+
+	// the last hit of the page is at number*size, counted in int64 so a large page number cannot overflow
+	if int64(page.Number)*int64(page.Size) > int64(maxResultWindow) {
+		return nil, fmt.Errorf("%w: page %d is past the first %d results, use search_after to go further", ErrInvalidPage, page.Number, maxResultWindow)
+	}
+
+	return page, nil
+}
+
+func (p *Page) from() int32 {
+	return (p.Number - 1) * p.Size
+}
+
+// Apply adds size, sort and from or search_after to an elastic query. One hit more than the page size is asked for
+// so Info can tell whether another page follows.
+func (p *Page) Apply(query map[string]interface{}) {
+	query["size"] = p.Size + 1
+	// _doc differs between replicas and changes with every merge, the id of the lemma does not. An index seeded
+	// before the id existed still sorts, its lemmas only lack the tie-breaker.
+	query["sort"] = []interface{}{
+		map[string]interface{}{"_score": "desc"},
+		map[string]interface{}{TieBreaker: map[string]interface{}{"order": "asc", "unmapped_type": "keyword"}},
+	}
+
+	if p.After != nil {
+		query["search_after"] = p.After
+		return
+	}
+	query["from"] = p.from()
+}
+
+// SearchResult is the part of an elastic search response needed to page through it.
+type SearchResult struct {
+	Took int64 `json:"took"`
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []SearchHit `json:"hits"`
+	} `json:"hits"`
+}
+
+type SearchHit struct {
+	ID     string          `json:"_id"`
+	Source json.RawMessage `json:"_source"`
+	Sort   []interface{}   `json:"sort"`
+}
+
+// DecodeSearchResult parses a raw elastic search response, keeping the sort values exact so they can be sent back
+// as search_after.
+func DecodeSearchResult(raw []byte) (*SearchResult, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var result SearchResult
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode search response: %w", err)
+	}
+	return &result, nil
+}
+
+// Lemmas maps the hits on this page to lemmas, dropping the extra hit asked for by Apply.
+func (p *Page) Lemmas(result *SearchResult) ([]*koinos.Lemma, error) {
+	hits := p.hits(result)
+	lemmas := make([]*koinos.Lemma, 0, len(hits))
+	for _, hit := range hits {
+		var src LemmaSource
+		if err := json.Unmarshal(hit.Source, &src); err != nil {
+			return nil, fmt.Errorf("decode _source: %w", err)
+		}
+		lemmas = append(lemmas, LemmaFromSource(src))
+	}
+	return lemmas, nil
+}
+
+// Info describes the page that was returned. With a cursor the page number is not known and left at 0.
+func (p *Page) Info(result *SearchResult) *koinos.PageInfo {
+	hits := p.hits(result)
+	info := &koinos.PageInfo{
+		Size:        p.Size,
+		Total:       int32(result.Hits.Total.Value),
+		HasNextPage: len(result.Hits.Hits) > len(hits),
+	}
+	if p.After == nil {
+		info.Page = p.Number
+	}
+
+	for _, hit := range hits {
+		cursor, err := encodeCursor(hit.Sort)
+		if err != nil {
+			// without sort values there is nothing to resume from, so the page is only reachable by number
+			info.Cursors = nil
+			return info
+		}
+		info.Cursors = append(info.Cursors, cursor)
+	}
+
+	if info.HasNextPage && len(info.Cursors) > 0 {
+		info.NextCursor = info.Cursors[len(info.Cursors)-1]
+	}
+	return info
+}
+
+func (p *Page) hits(result *SearchResult) []SearchHit {
+	if int32(len(result.Hits.Hits)) > p.Size {
+		return result.Hits.Hits[:p.Size]
+	}
+	return result.Hits.Hits
+}
+
+func encodeCursor(sort []interface{}) (string, error) {
+	if len(sort) == 0 {
+		return "", errors.New("hit has no sort values")
+	}
+	b, err := json.Marshal(sort)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(cursor string) ([]interface{}, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var after []interface{}
+	if err := decoder.Decode(&after); err != nil {
+		return nil, err
+	}
+	if len(after) == 0 {
+		return nil, errors.New("empty cursor")
+	}
+	return after, nil
+}
@@ -0,0 +1,161 @@
+package hetairoi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageFromQuery(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		page, err := PageFromQuery(&koinos.SearchQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), page.Number)
+		assert.Equal(t, DefaultPageSize, page.Size)
+		assert.Nil(t, page.After)
+	})
+
+	t.Run("CapsTheSize", func(t *testing.T) {
+		page, err := PageFromQuery(&koinos.SearchQuery{NumberOfResults: MaxPageSize * 2})
+		assert.Nil(t, err)
+		assert.Equal(t, MaxPageSize, page.Size)
+	})
+
+	t.Run("RejectsNegatives", func(t *testing.T) {
+		_, err := PageFromQuery(&koinos.SearchQuery{Page: -1})
+		assert.True(t, errors.Is(err, ErrInvalidPage))
+		_, err = PageFromQuery(&koinos.SearchQuery{NumberOfResults: -1})
+		assert.True(t, errors.Is(err, ErrInvalidPage))
+	})
+
+	t.Run("LastPageInsideTheWindow", func(t *testing.T) {
+		page, err := PageFromQuery(&koinos.SearchQuery{Page: 100, NumberOfResults: 100})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(9900), page.from())
+	})
+
+	t.Run("RejectsPagesPastTheWindow", func(t *testing.T) {
+		_, err := PageFromQuery(&koinos.SearchQuery{Page: 101, NumberOfResults: 100})
+		assert.True(t, errors.Is(err, ErrInvalidPage))
+
+		// (page-1)*size wraps around in int32, the check must not
+		_, err = PageFromQuery(&koinos.SearchQuery{Page: math.MaxInt32, NumberOfResults: 100})
+		assert.True(t, errors.Is(err, ErrInvalidPage))
+		_, err = PageFromQuery(&koinos.SearchQuery{Page: 42949673, NumberOfResults: 100})
+		assert.True(t, errors.Is(err, ErrInvalidPage))
+	})
+
+	t.Run("CursorSkipsTheWindow", func(t *testing.T) {
+		cursor, err := encodeCursor([]interface{}{json.Number("1.5"), "b9a1"})
+		assert.Nil(t, err)
+
+		page, err := PageFromQuery(&koinos.SearchQuery{Page: 500, NumberOfResults: 100, SearchAfter: cursor})
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{json.Number("1.5"), "b9a1"}, page.After)
+	})
+
+	t.Run("RejectsMalformedCursors", func(t *testing.T) {
+		for _, cursor := range []string{"not base64!", "bm90IGpzb24", "W10"} {
+			_, err := PageFromQuery(&koinos.SearchQuery{SearchAfter: cursor})
+			assert.True(t, errors.Is(err, ErrInvalidPage), cursor)
+		}
+	})
+}
+
+func TestCursor(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		// a score with more digits than a float64 prints, and an id
+		sort := []interface{}{json.Number("12.345678901234567890"), "4f1c2d"}
+		cursor, err := encodeCursor(sort)
+		assert.Nil(t, err)
+
+		after, err := decodeCursor(cursor)
+		assert.Nil(t, err)
+		assert.Equal(t, sort, after)
+	})
+
+	t.Run("NoSortValues", func(t *testing.T) {
+		_, err := encodeCursor(nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestApply(t *testing.T) {
+	sort := []interface{}{
+		map[string]interface{}{"_score": "desc"},
+		map[string]interface{}{TieBreaker: map[string]interface{}{"order": "asc", "unmapped_type": "keyword"}},
+	}
+
+	t.Run("ByNumber", func(t *testing.T) {
+		query := map[string]interface{}{}
+		(&Page{Number: 3, Size: 10}).Apply(query)
+		assert.Equal(t, int32(11), query["size"])
+		assert.Equal(t, int32(20), query["from"])
+		assert.Equal(t, sort, query["sort"])
+		assert.NotContains(t, query, "search_after")
+	})
+
+	t.Run("ByCursor", func(t *testing.T) {
+		query := map[string]interface{}{}
+		after := []interface{}{json.Number("1.5"), "b9a1"}
+		(&Page{Number: 3, Size: 10, After: after}).Apply(query)
+		assert.Equal(t, after, query["search_after"])
+		assert.Equal(t, sort, query["sort"])
+		assert.NotContains(t, query, "from")
+	})
+}
+
+func searchResult(t *testing.T, total int64, hits int) *SearchResult {
+	t.Helper()
+	raw := fmt.Sprintf(`{"took": 3, "hits": {"total": {"value": %d}, "hits": [`, total)
+	for i := 0; i < hits; i++ {
+		if i > 0 {
+			raw += ","
+		}
+		raw += fmt.Sprintf(`{"_id": "%d", "_source": {"greek": "λόγος"}, "sort": [1.5, "id-%d"]}`, i, i)
+	}
+	raw += `]}}`
+
+	result, err := DecodeSearchResult([]byte(raw))
+	assert.Nil(t, err)
+	return result
+}
+
+func TestInfo(t *testing.T) {
+	t.Run("MoreToCome", func(t *testing.T) {
+		page := &Page{Number: 2, Size: 2}
+		info := page.Info(searchResult(t, 7, 3))
+		assert.Equal(t, int32(2), info.Page)
+		assert.Equal(t, int32(2), info.Size)
+		assert.Equal(t, int32(7), info.Total)
+		assert.True(t, info.HasNextPage)
+		assert.Len(t, info.Cursors, 2)
+		assert.Equal(t, info.Cursors[1], info.NextCursor)
+
+		after, err := decodeCursor(info.NextCursor)
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{json.Number("1.5"), "id-1"}, after)
+
+		lemmas, err := page.Lemmas(searchResult(t, 7, 3))
+		assert.Nil(t, err)
+		assert.Len(t, lemmas, 2)
+	})
+
+	t.Run("LastPage", func(t *testing.T) {
+		info := (&Page{Number: 4, Size: 2}).Info(searchResult(t, 7, 1))
+		assert.False(t, info.HasNextPage)
+		assert.Empty(t, info.NextCursor)
+		assert.Len(t, info.Cursors, 1)
+	})
+
+	t.Run("ByCursorLeavesThePageUnset", func(t *testing.T) {
+		info := (&Page{Number: 3, Size: 2, After: []interface{}{json.Number("1.5"), "id-1"}}).Info(searchResult(t, 7, 3))
+		assert.Zero(t, info.Page)
+		assert.True(t, info.HasNextPage)
+	})
+}
@@ -0,0 +1,115 @@
+// Package hygieia serves the standard grpc.health.v1.Health protocol next to the custom Health RPCs of the makedonia
+// services, so Kubernetes probes, grpcurl and grpc-health-probe work without a client of their own.
+package hygieia
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	DefaultInterval    = 10 * time.Second
+	DefaultWaitTimeout = 30 * time.Second
+	checkTimeout       = 5 * time.Second
+)
+
+// Check reports whether the store a service depends on, Elasticsearch or a cache, can be used.
+type Check func(ctx context.Context) bool
+
+// Config tells Register how often to run the check and whether to expose server reflection.
+type Config struct {
+	Interval   time.Duration
+	Reflection bool
+}
+
+// Server keeps the status of a service and of the server as a whole ("") in line with its check.
+type Server struct {
+	health  *health.Server
+	service string
+	check   Check
+}
+
+// Register adds the health service, and reflection when enabled, to server and runs check every interval until ctx
+// is done. The status starts as NOT_SERVING until the first check passed.
+func Register(ctx context.Context, server *grpc.Server, service string, check Check, cfg Config) *Server {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+
+	s := &Server{
+		health:  health.NewServer(),
+		service: service,
+		check:   check,
+	}
+	s.setStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, s.health)
+
+	if cfg.Reflection {
+		reflection.Register(server)
+	}
+
+	s.update(ctx)
+	go s.watch(ctx, cfg.Interval)
+
+	return s
+}
+
+// Shutdown reports NOT_SERVING from now on, whatever the check says, so probes stop sending traffic while the
+// server drains.
+func (s *Server) Shutdown() {
+	s.health.Shutdown()
+}
+
+func (s *Server) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.update(ctx)
+		}
+	}
+}
+
+func (s *Server) update(ctx context.Context) {
+	checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	if s.check(checkCtx) {
+		s.setStatus(grpc_health_v1.HealthCheckResponse_SERVING)
+		return
+	}
+	s.setStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+}
+
+func (s *Server) setStatus(status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	s.health.SetServingStatus(s.service, status)
+	s.health.SetServingStatus("", status)
+}
+
+// WaitForServing asks the health service until service reports SERVING or the timeout passed.
+func WaitForServing(client grpc_health_v1.HealthClient, service string, timeout time.Duration) bool {
+	checkInterval := 1 * time.Second
+	endTime := time.Now().Add(timeout)
+
+	for time.Now().Before(endTime) {
+		ctx, cancel := context.WithTimeout(context.Background(), checkInterval)
+		response, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		cancel()
+		if err == nil && response.Status == grpc_health_v1.HealthCheckResponse_SERVING {
+			return true
+		}
+
+		time.Sleep(checkInterval)
+	}
+
+	return false
+}
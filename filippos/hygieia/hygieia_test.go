@@ -0,0 +1,82 @@
+package hygieia
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func status(t *testing.T, s *Server, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	response, err := s.health.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	assert.Nil(t, err)
+	return response.Status
+}
+
+type fakeHealthClient struct {
+	grpc_health_v1.HealthClient
+	calls   atomic.Int32
+	serveAt int32
+}
+
+func (f *fakeHealthClient) Check(_ context.Context, _ *grpc_health_v1.HealthCheckRequest, _ ...grpc.CallOption) (*grpc_health_v1.HealthCheckResponse, error) {
+	if f.calls.Add(1) >= f.serveAt {
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+}
+
+func TestRegister(t *testing.T) {
+	const service = "makedonia.test.v1.TestService"
+
+	t.Run("FollowsTheCheck", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var healthy atomic.Bool
+		healthy.Store(true)
+		sut := Register(ctx, grpc.NewServer(), service, func(context.Context) bool {
+			return healthy.Load()
+		}, Config{Interval: 10 * time.Millisecond})
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, status(t, sut, service))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, status(t, sut, ""))
+
+		healthy.Store(false)
+		assert.Eventually(t, func() bool {
+			return status(t, sut, service) == grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("ShutdownOverridesTheCheck", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sut := Register(ctx, grpc.NewServer(), service, func(context.Context) bool {
+			return true
+		}, Config{Interval: 10 * time.Millisecond})
+
+		sut.Shutdown()
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, status(t, sut, service))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, status(t, sut, ""))
+	})
+}
+
+func TestWaitForServing(t *testing.T) {
+	t.Run("WaitsUntilServing", func(t *testing.T) {
+		client := &fakeHealthClient{serveAt: 2}
+		assert.True(t, WaitForServing(client, "", 5*time.Second))
+		assert.Equal(t, int32(2), client.calls.Load())
+	})
+
+	t.Run("GivesUpAfterTheTimeout", func(t *testing.T) {
+		client := &fakeHealthClient{serveAt: 100}
+		assert.False(t, WaitForServing(client, "", 10*time.Millisecond))
+	})
+}
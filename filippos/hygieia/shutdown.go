@@ -0,0 +1,82 @@
+package hygieia
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// The defaults leave room for both steps within the 30 seconds Kubernetes waits between SIGTERM and SIGKILL.
+const (
+	DefaultDrainDelay      = 5 * time.Second
+	DefaultShutdownTimeout = 20 * time.Second
+
+	envDrainDelay      = "SHUTDOWN_DRAIN_DELAY"
+	envShutdownTimeout = "SHUTDOWN_TIMEOUT"
+)
+
+// ShutdownConfig tells a server how long to keep serving once it reports NOT_SERVING, so the probes can take it out
+// of rotation, and how long to wait for in-flight calls after that.
+type ShutdownConfig struct {
+	DrainDelay time.Duration
+	Timeout    time.Duration
+}
+
+// ShutdownConfigFromEnv reads SHUTDOWN_DRAIN_DELAY and SHUTDOWN_TIMEOUT, falling back to the defaults.
+func ShutdownConfigFromEnv() (ShutdownConfig, error) {
+	cfg := ShutdownConfig{
+		DrainDelay: DefaultDrainDelay,
+		Timeout:    DefaultShutdownTimeout,
+	}
+
+	for env, target := range map[string]*time.Duration{
+		envDrainDelay:      &cfg.DrainDelay,
+		envShutdownTimeout: &cfg.Timeout,
+	} {
+		value, ok := os.LookupEnv(env)
+		if !ok || value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", env, err)
+		}
+		*target = duration
+	}
+
+	return cfg, nil
+}
+
+// GracefulStop reports NOT_SERVING, keeps serving for the drain delay and then stops server once the in-flight calls
+// finished. Calls still running after the timeout, long-lived streams among them, are cut off. It reports whether
+// the server stopped in time.
+//
+// Only once it returned can a service close what its calls use, the trace stream and the Badger cache, which is what
+// the Close of every service does.
+func GracefulStop(server *grpc.Server, health *Server, cfg ShutdownConfig) bool {
+	if health != nil {
+		health.Shutdown()
+	}
+	time.Sleep(cfg.DrainDelay)
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(cfg.Timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		server.Stop()
+		<-stopped
+		return false
+	}
+}
@@ -0,0 +1,95 @@
+package hygieia
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func serve(t *testing.T, ctx context.Context) (*grpc.Server, *Server, grpc_health_v1.HealthClient) {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	health := Register(ctx, server, "", func(context.Context) bool { return true }, Config{})
+	go server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return server, health, grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestGracefulStop(t *testing.T) {
+	cfg := ShutdownConfig{DrainDelay: 10 * time.Millisecond, Timeout: 200 * time.Millisecond}
+
+	t.Run("StopsOnceIdle", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		server, health, client := serve(t, ctx)
+		_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		assert.Nil(t, err)
+
+		assert.True(t, GracefulStop(server, health, cfg))
+	})
+
+	t.Run("CutsOffLongLivedStreams", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		server, health, client := serve(t, ctx)
+		stream, err := client.Watch(ctx, &grpc_health_v1.HealthCheckRequest{})
+		assert.Nil(t, err)
+		response, err := stream.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.Status)
+
+		start := time.Now()
+		assert.False(t, GracefulStop(server, health, cfg))
+		assert.Less(t, time.Since(start), time.Second)
+
+		// the watcher hears about the shutdown before the stream is cut off
+		response, err = stream.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, response.Status)
+	})
+}
+
+func TestShutdownConfigFromEnv(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg, err := ShutdownConfigFromEnv()
+		assert.Nil(t, err)
+		assert.Equal(t, DefaultDrainDelay, cfg.DrainDelay)
+		assert.Equal(t, DefaultShutdownTimeout, cfg.Timeout)
+	})
+
+	t.Run("FromEnv", func(t *testing.T) {
+		t.Setenv(envDrainDelay, "0s")
+		t.Setenv(envShutdownTimeout, "45s")
+
+		cfg, err := ShutdownConfigFromEnv()
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(0), cfg.DrainDelay)
+		assert.Equal(t, 45*time.Second, cfg.Timeout)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv(envShutdownTimeout, "soon")
+
+		_, err := ShutdownConfigFromEnv()
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,205 @@
+// Package themelios lays the foundation the makedonia services start from: the trace stream, the Elasticsearch
+// credentials vault hands out through the ambassador, the Elasticsearch client and index, the Badger cache and the
+// herodotos client. A service asks for what it needs with options and gets it back as one Dependencies bundle.
+package themelios
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/odysseia-greek/agora/archytas"
+	"github.com/odysseia-greek/agora/aristoteles"
+	"github.com/odysseia-greek/agora/aristoteles/models"
+	"github.com/odysseia-greek/agora/plato/config"
+	"github.com/odysseia-greek/agora/plato/randomizer"
+	"github.com/odysseia-greek/agora/plato/service"
+	aristophanes "github.com/odysseia-greek/attike/aristophanes/comedy"
+	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
+	"github.com/odysseia-greek/delphi/aristides/diplomat"
+	pb "github.com/odysseia-greek/delphi/aristides/proto"
+)
+
+const secretTimeout = 30 * time.Second
+
+// Tracer is the part of the aristophanes client a service needs to open its trace stream.
+type Tracer interface {
+	WaitForHealthyState() bool
+	Chorus(ctx context.Context) (arv1.TraceService_ChorusClient, error)
+}
+
+// Ambassador is the part of the diplomat client that hands out the Elasticsearch credentials.
+type Ambassador interface {
+	WaitForHealthyState() bool
+	GetSecret(ctx context.Context, request *pb.VaultRequest) (*pb.ElasticConfigVault, error)
+}
+
+// Providers create the clients Bootstrap wires together. DefaultProviders talks to the real services, tests swap in
+// fakes through WithProviders.
+type Providers struct {
+	Tracer     func() (Tracer, error)
+	Ambassador func() (Ambassador, error)
+	// Elastic returns a client that answered its health check
+	Elastic   func(cfg models.Config) (aristoteles.Client, error)
+	Cache     func() (archytas.Client, error)
+	Herodotos func() (service.OdysseiaClient, error)
+}
+
+func DefaultProviders() Providers {
+	return Providers{
+		Tracer: func() (Tracer, error) {
+			return aristophanes.NewClientTracer(aristophanes.DefaultAddress)
+		},
+		Ambassador: func() (Ambassador, error) {
+			return diplomat.NewClientAmbassador(diplomat.DEFAULTADDRESS)
+		},
+		Elastic: func(cfg models.Config) (aristoteles.Client, error) {
+			elastic, err := aristoteles.NewClient(cfg)
+			if err != nil {
+				return nil, err
+			}
+			if err := aristoteles.HealthCheck(elastic); err != nil {
+				return nil, err
+			}
+			return elastic, nil
+		},
+		Cache:     archytas.CreateBadgerClient,
+		Herodotos: config.CreateOdysseiaClient,
+	}
+}
+
+// Dependencies is what Bootstrap hands back. The fields whose option was not set stay empty.
+type Dependencies struct {
+	Streamer   arv1.TraceService_ChorusClient
+	Randomizer randomizer.Random
+	Version    string
+	// Elastic and Index are set by WithElastic
+	Elastic aristoteles.Client
+	Index   string
+	// Cache is set by WithCache
+	Cache archytas.Client
+	// Client is set by WithHerodotos
+	Client service.OdysseiaClient
+}
+
+type options struct {
+	elastic   bool
+	cache     bool
+	herodotos bool
+	providers Providers
+}
+
+type Option func(*options)
+
+// WithElastic fetches the credentials from vault, connects to Elasticsearch and reads the index from ELASTIC_ACCESS.
+func WithElastic() Option {
+	return func(o *options) { o.elastic = true }
+}
+
+// WithCache opens the Badger cache.
+func WithCache() Option {
+	return func(o *options) { o.cache = true }
+}
+
+// WithHerodotos creates the odysseia client used to call herodotos.
+func WithHerodotos() Option {
+	return func(o *options) { o.herodotos = true }
+}
+
+func WithProviders(providers Providers) Option {
+	return func(o *options) { o.providers = providers }
+}
+
+// Bootstrap waits for the tracer, opens the trace stream and creates whatever the options ask for. The first failure
+// is returned, there is no point in starting a service with half of its dependencies.
+func Bootstrap(ctx context.Context, opts ...Option) (*Dependencies, error) {
+	o := options{providers: DefaultProviders()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	deps := &Dependencies{
+		Version: os.Getenv(config.EnvVersion),
+	}
+
+	if o.elastic {
+		// checked before dialing anything, a missing index is a deployment mistake no retry will fix
+		deps.Index = config.StringFromEnv(config.EnvIndex, "")
+		if deps.Index == "" {
+			return nil, fmt.Errorf("no index found in environment please set %s", config.EnvIndex)
+		}
+	}
+
+	tracer, err := o.providers.Tracer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracer: %w", err)
+	}
+	if !tracer.WaitForHealthyState() {
+		return nil, errors.New("tracing service not ready")
+	}
+
+	deps.Streamer, err = tracer.Chorus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace stream: %w", err)
+	}
+
+	deps.Randomizer, err = config.CreateNewRandomizer()
+	if err != nil {
+		return nil, err
+	}
+
+	if o.elastic {
+		deps.Elastic, err = connectElastic(o.providers, deps.Streamer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if o.cache {
+		deps.Cache, err = o.providers.Cache()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open cache: %w", err)
+		}
+	}
+
+	if o.herodotos {
+		deps.Client, err = o.providers.Herodotos()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create herodotos client: %w", err)
+		}
+	}
+
+	return deps, nil
+}
+
+func connectElastic(providers Providers, streamer arv1.TraceService_ChorusClient) (aristoteles.Client, error) {
+	ambassador, err := providers.Ambassador()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ambassador: %w", err)
+	}
+	if !ambassador.WaitForHealthyState() {
+		return nil, errors.New("ambassador service not ready")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretTimeout)
+	defer cancel()
+
+	vaultConfig, err := getSecret(ctx, ambassador, streamer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get elastic credentials: %w", err)
+	}
+
+	elastic, err := providers.Elastic(models.Config{
+		Service:     aristoteles.ElasticService(config.BoolFromEnv(config.EnvTlSKey)),
+		Username:    vaultConfig.ElasticUsername,
+		Password:    vaultConfig.ElasticPassword,
+		ElasticCERT: vaultConfig.ElasticCERT,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to elastic: %w", err)
+	}
+
+	return elastic, nil
+}
@@ -0,0 +1,200 @@
+package themelios
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/odysseia-greek/agora/archytas"
+	"github.com/odysseia-greek/agora/aristoteles"
+	"github.com/odysseia-greek/agora/aristoteles/models"
+	"github.com/odysseia-greek/agora/plato/config"
+	"github.com/odysseia-greek/agora/plato/service"
+	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
+	pb "github.com/odysseia-greek/delphi/aristides/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeStream struct {
+	arv1.TraceService_ChorusClient
+	sent []*arv1.ObserveRequest
+}
+
+func (f *fakeStream) Send(request *arv1.ObserveRequest) error {
+	f.sent = append(f.sent, request)
+	return nil
+}
+
+type fakeTracer struct {
+	healthy bool
+	stream  *fakeStream
+}
+
+func (f *fakeTracer) WaitForHealthyState() bool {
+	return f.healthy
+}
+
+func (f *fakeTracer) Chorus(context.Context) (arv1.TraceService_ChorusClient, error) {
+	return f.stream, nil
+}
+
+type fakeAmbassador struct {
+	vault    *pb.ElasticConfigVault
+	err      error
+	metadata metadata.MD
+}
+
+func (f *fakeAmbassador) WaitForHealthyState() bool {
+	return true
+}
+
+func (f *fakeAmbassador) GetSecret(ctx context.Context, _ *pb.VaultRequest) (*pb.ElasticConfigVault, error) {
+	f.metadata, _ = metadata.FromOutgoingContext(ctx)
+	return f.vault, f.err
+}
+
+type fakeElastic struct {
+	aristoteles.Client
+	cfg models.Config
+}
+
+type fakeCache struct {
+	archytas.Client
+}
+
+type fakeHerodotos struct {
+	service.OdysseiaClient
+}
+
+type fakes struct {
+	stream     *fakeStream
+	tracer     *fakeTracer
+	ambassador *fakeAmbassador
+	elastic    *fakeElastic
+	calls      map[string]int
+}
+
+func newFakes() *fakes {
+	stream := &fakeStream{}
+	return &fakes{
+		stream: stream,
+		tracer: &fakeTracer{healthy: true, stream: stream},
+		ambassador: &fakeAmbassador{vault: &pb.ElasticConfigVault{
+			ElasticUsername: "alexandros",
+			ElasticPassword: "bucephalus",
+		}},
+		calls: map[string]int{},
+	}
+}
+
+func (f *fakes) providers() Providers {
+	return Providers{
+		Tracer: func() (Tracer, error) {
+			f.calls["tracer"]++
+			return f.tracer, nil
+		},
+		Ambassador: func() (Ambassador, error) {
+			f.calls["ambassador"]++
+			return f.ambassador, nil
+		},
+		Elastic: func(cfg models.Config) (aristoteles.Client, error) {
+			f.calls["elastic"]++
+			f.elastic = &fakeElastic{cfg: cfg}
+			return f.elastic, nil
+		},
+		Cache: func() (archytas.Client, error) {
+			f.calls["cache"]++
+			return &fakeCache{}, nil
+		},
+		Herodotos: func() (service.OdysseiaClient, error) {
+			f.calls["herodotos"]++
+			return &fakeHerodotos{}, nil
+		},
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TracerOnly", func(t *testing.T) {
+		fake := newFakes()
+		deps, err := Bootstrap(ctx, WithProviders(fake.providers()))
+		assert.Nil(t, err)
+		assert.Equal(t, fake.stream, deps.Streamer)
+		assert.NotNil(t, deps.Randomizer)
+		assert.Nil(t, deps.Elastic)
+		assert.Nil(t, deps.Cache)
+		assert.Nil(t, deps.Client)
+		assert.Equal(t, map[string]int{"tracer": 1}, fake.calls)
+	})
+
+	t.Run("WithElastic", func(t *testing.T) {
+		t.Setenv(config.EnvIndex, "dictionary")
+		fake := newFakes()
+
+		deps, err := Bootstrap(ctx, WithProviders(fake.providers()), WithElastic())
+		assert.Nil(t, err)
+		assert.Equal(t, "dictionary", deps.Index)
+		assert.Equal(t, fake.elastic, deps.Elastic)
+		assert.Equal(t, "alexandros", fake.elastic.cfg.Username)
+		assert.Equal(t, "bucephalus", fake.elastic.cfg.Password)
+
+		// the secret is fetched inside a trace the ambassador can follow
+		assert.Len(t, fake.stream.sent, 2)
+		assert.NotNil(t, fake.stream.sent[0].GetTraceStart())
+		assert.NotNil(t, fake.stream.sent[1].GetTraceStop())
+		assert.Contains(t, fake.ambassador.metadata.Get(service.HeaderKey)[0], fake.stream.sent[0].TraceId)
+	})
+
+	t.Run("MissingIndex", func(t *testing.T) {
+		t.Setenv(config.EnvIndex, "")
+		fake := newFakes()
+
+		_, err := Bootstrap(ctx, WithProviders(fake.providers()), WithElastic())
+		assert.NotNil(t, err)
+		assert.Empty(t, fake.calls)
+	})
+
+	t.Run("TracerNotReady", func(t *testing.T) {
+		fake := newFakes()
+		fake.tracer.healthy = false
+
+		_, err := Bootstrap(ctx, WithProviders(fake.providers()), WithCache())
+		assert.NotNil(t, err)
+		assert.Zero(t, fake.calls["cache"])
+	})
+
+	t.Run("SecretFails", func(t *testing.T) {
+		t.Setenv(config.EnvIndex, "dictionary")
+		fake := newFakes()
+		denied := errors.New("permission denied")
+		fake.ambassador.vault, fake.ambassador.err = nil, denied
+
+		_, err := Bootstrap(ctx, WithProviders(fake.providers()), WithElastic())
+		assert.ErrorIs(t, err, denied)
+		assert.Zero(t, fake.calls["elastic"])
+		// the trace is closed either way
+		assert.Len(t, fake.stream.sent, 2)
+	})
+
+	t.Run("CacheAndHerodotos", func(t *testing.T) {
+		fake := newFakes()
+
+		deps, err := Bootstrap(ctx, WithProviders(fake.providers()), WithCache(), WithHerodotos())
+		assert.Nil(t, err)
+		assert.NotNil(t, deps.Cache)
+		assert.NotNil(t, deps.Client)
+		assert.Zero(t, fake.calls["ambassador"])
+	})
+
+	t.Run("CacheFails", func(t *testing.T) {
+		fake := newFakes()
+		locked := errors.New("cannot acquire directory lock")
+		providers := fake.providers()
+		providers.Cache = func() (archytas.Client, error) { return nil, locked }
+
+		_, err := Bootstrap(ctx, WithProviders(providers), WithCache())
+		assert.ErrorIs(t, err, locked)
+	})
+}
@@ -0,0 +1,70 @@
+package themelios
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/odysseia-greek/agora/plato/logging"
+	"github.com/odysseia-greek/agora/plato/service"
+	aristophanes "github.com/odysseia-greek/attike/aristophanes/comedy"
+	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
+	"github.com/odysseia-greek/delphi/aristides/diplomat"
+	pb "github.com/odysseia-greek/delphi/aristides/proto"
+	"google.golang.org/grpc/metadata"
+)
+
+const getSecretOperation = "/delphi_ptolemaios.Ptolemaios/GetSecret"
+
+// getSecret asks the ambassador for the Elasticsearch credentials inside a trace of its own, the ambassador picks the
+// trace up from the header and vault audits the request under it.
+func getSecret(ctx context.Context, ambassador Ambassador, streamer arv1.TraceService_ChorusClient) (*pb.ElasticConfigVault, error) {
+	traceID := uuid.New().String()
+	spanID := aristophanes.GenerateSpanID()
+
+	sendTrace(streamer, &arv1.ObserveRequest{
+		TraceId:      traceID,
+		ParentSpanId: spanID,
+		SpanId:       spanID,
+		Kind: &arv1.ObserveRequest_TraceStart{
+			TraceStart: &arv1.ObserveTraceStart{
+				Method:    "GetSecret",
+				Url:       diplomat.DEFAULTADDRESS,
+				Operation: getSecretOperation,
+			},
+		},
+	})
+	logging.Trace(fmt.Sprintf("trace with requestID: %s and span: %s", traceID, spanID))
+
+	combinedID := fmt.Sprintf("%s+%s+%d", traceID, spanID, 1)
+	ctx = metadata.AppendToOutgoingContext(ctx, service.HeaderKey, combinedID)
+
+	vaultConfig, err := ambassador.GetSecret(ctx, &pb.VaultRequest{})
+	responseBody := ""
+	if err != nil {
+		responseBody = fmt.Sprintf("failed to retrieve user from vault: %s", err.Error())
+	} else {
+		responseBody = fmt.Sprintf("user retrieved from vault: %s", vaultConfig.ElasticUsername)
+	}
+
+	sendTrace(streamer, &arv1.ObserveRequest{
+		TraceId:      traceID,
+		ParentSpanId: spanID,
+		SpanId:       spanID,
+		Kind: &arv1.ObserveRequest_TraceStop{
+			TraceStop: &arv1.ObserveTraceStop{
+				ResponseBody: responseBody,
+			},
+		},
+	})
+	logging.Trace(fmt.Sprintf("trace closed with id: %s", traceID))
+
+	return vaultConfig, err
+}
+
+// sendTrace only logs a failure, a missing trace is no reason to stop a service from starting.
+func sendTrace(streamer arv1.TraceService_ChorusClient, request *arv1.ObserveRequest) {
+	if err := streamer.Send(request); err != nil {
+		logging.Error(fmt.Sprintf("failed to send trace data: %v", err))
+	}
+}
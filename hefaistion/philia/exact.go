@@ -2,17 +2,17 @@ package philia
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync/atomic"
 	"time"
 
-	"github.com/odysseia-greek/agora/aristoteles/models"
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/agora/plato/transform"
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
 	"github.com/odysseia-greek/makedonia/filippos/hetairoi"
 	v1 "github.com/odysseia-greek/makedonia/hefaistion/gen/go/v1"
 	"google.golang.org/grpc/peer"
@@ -36,6 +36,16 @@ func (e *ExactServiceImpl) Health(ctx context.Context, request *emptypb.Empty) (
 	}, nil
 }
 
+// Healthy drives the grpc.health.v1 status, the service is of no use without Elasticsearch.
+func (e *ExactServiceImpl) Healthy(ctx context.Context) bool {
+	return e.Elastic.Health().Info().Healthy
+}
+
+// Close ends the trace stream and closes the cache.
+func (e *ExactServiceImpl) Close() error {
+	return errors.Join(e.Streamer.CloseSend(), e.Archytas.Close())
+}
+
 func (e *ExactServiceImpl) Search(ctx context.Context, request *koinos.SearchQuery) (*v1.SearchResponse, error) {
 	baseWord, strippedWord := extractBaseWord(request.Word)
 	go e.recordRequest(ctx)
@@ -49,48 +59,41 @@ func (e *ExactServiceImpl) Search(ctx context.Context, request *koinos.SearchQue
 	case koinos.Language_LANG_DUTCH:
 		language = "dutch"
 	default:
-		return nil, fmt.Errorf("unsupported language: %v", request.Language)
+		return nil, hamartia.UnsupportedLanguage(request.Language)
 	}
 
-	if request.NumberOfResults == 0 {
-		request.NumberOfResults = 5
+	page, err := hetairoi.PageFromQuery(request)
+	if err != nil {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidPage, err)
 	}
 
-	elasticResponse, hitsTotal, err := e.queryElastic(ctx, baseWord, language, false, request.NumberOfResults)
+	elasticResponse, err := e.queryElastic(ctx, baseWord, language, false, page)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(elasticResponse.Hits.Hits) == 0 {
+	// later pages can be empty too, only a word that matches nothing at all falls back to the normalized form
+	if elasticResponse.Hits.Total.Value == 0 {
 		logging.Debug("no hits found trying with a word without diacretics")
-		elasticResponse, hitsTotal, err = e.queryElastic(ctx, strippedWord, language, true, request.NumberOfResults)
+		elasticResponse, err = e.queryElastic(ctx, strippedWord, language, true, page)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	results := make([]*koinos.Lemma, 0, len(elasticResponse.Hits.Hits))
-	for _, hit := range elasticResponse.Hits.Hits {
-		source, _ := json.Marshal(hit.Source)
-		var src hetairoi.LemmaSource
-		if err := json.Unmarshal(source, &src); err != nil {
-			b, _ := json.Marshal(hit.Source)
-			if err2 := json.Unmarshal(b, &src); err2 != nil {
-				return nil, fmt.Errorf("decode _source: %w", err2)
-			}
-		}
-
-		results = append(results, hetairoi.LemmaFromSource(src))
+	results, err := page.Lemmas(elasticResponse)
+	if err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
 
 	resp := &v1.SearchResponse{
 		Results:  results,
-		PageInfo: &koinos.PageInfo{Page: 1, Size: int32(len(results)), Total: int32(hitsTotal)},
+		PageInfo: page.Info(elasticResponse),
 	}
 	return resp, nil
 }
 
-func (e *ExactServiceImpl) queryElastic(ctx context.Context, word, language string, normalized bool, results int32) (*models.Response, int64, error) {
+func (e *ExactServiceImpl) queryElastic(ctx context.Context, word, language string, normalized bool, page *hetairoi.Page) (*hetairoi.SearchResult, error) {
 	var query map[string]interface{}
 
 	if normalized {
@@ -119,22 +122,23 @@ func (e *ExactServiceImpl) queryElastic(ctx context.Context, word, language stri
 					},
 				},
 			},
-			"size": results,
 		}
 	}
 
-	elasticResponse, err := e.Elastic.Query().Match(e.Index, query)
+	page.Apply(query)
+
+	raw, err := e.Elastic.Query().MatchRaw(e.Index, query)
 	if err != nil {
-		return nil, 0, fmt.Errorf("error querying elastic: %w", err)
+		return nil, hamartia.Backend(ctx, hamartia.ReasonSearchBackend, fmt.Errorf("error querying elastic: %w", err))
 	}
 
-	hitsTotal := int64(0)
-	if elasticResponse.Hits.Hits != nil {
-		hitsTotal = elasticResponse.Hits.Total.Value
+	elasticResponse, err := hetairoi.DecodeSearchResult(raw)
+	if err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
-	go comedy.DatabaseSpan(query, hitsTotal, elasticResponse.Took, ctx, e.Streamer)
+	go comedy.DatabaseSpan(query, elasticResponse.Hits.Total.Value, elasticResponse.Took, ctx, e.Streamer)
 
-	return elasticResponse, hitsTotal, nil
+	return elasticResponse, nil
 }
 
 func extractBaseWord(queryWord string) (string, string) {
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
-	"time"
 
 	"github.com/odysseia-greek/agora/archytas"
 	"github.com/odysseia-greek/agora/aristoteles"
@@ -13,9 +12,11 @@ import (
 	"github.com/odysseia-greek/agora/plato/service"
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	v1 "github.com/odysseia-greek/makedonia/hefaistion/gen/go/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -46,7 +47,8 @@ type ExactServiceClient struct {
 	Impl ExactService
 }
 type ExactClient struct {
-	exact v1.HefastionServiceClient
+	exact  v1.HefastionServiceClient
+	health grpc_health_v1.HealthClient
 }
 
 func NewHefaistionClient(address string) (*ExactClient, error) {
@@ -58,24 +60,11 @@ func NewHefaistionClient(address string) (*ExactClient, error) {
 		return nil, fmt.Errorf("failed to connect to tracing service: %w", err)
 	}
 	client := v1.NewHefastionServiceClient(conn)
-	return &ExactClient{exact: client}, nil
+	return &ExactClient{exact: client, health: grpc_health_v1.NewHealthClient(conn)}, nil
 }
 
 func (e *ExactClient) WaitForHealthyState() bool {
-	timeout := 30 * time.Second
-	checkInterval := 1 * time.Second
-	endTime := time.Now().Add(timeout)
-
-	for time.Now().Before(endTime) {
-		response, err := e.Health(context.Background(), &emptypb.Empty{})
-		if err == nil && response.Healthy {
-			return true
-		}
-
-		time.Sleep(checkInterval)
-	}
-
-	return false
+	return hygieia.WaitForServing(e.health, v1.HefastionService_ServiceDesc.ServiceName, hygieia.DefaultWaitTimeout)
 }
 
 func (e *ExactClient) Health(ctx context.Context, request *emptypb.Empty) (*koinos.HealthResponse, error) {
@@ -6,14 +6,16 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	v1 "github.com/odysseia-greek/makedonia/parmenion/gen/go/v1"
 	"github.com/odysseia-greek/makedonia/parmenion/strategos"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 )
 
 const standardPort = ":50060"
@@ -46,6 +48,11 @@ func main() {
 		log.Fatal("death has found me")
 	}
 
+	shutdown, err := hygieia.ShutdownConfigFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -64,12 +71,27 @@ func main() {
 		),
 	)
 
-	reflection.Register(server)
-
 	v1.RegisterParmenionServiceServer(server, cfg)
+	health := hygieia.Register(ctx, server, v1.ParmenionService_ServiceDesc.ServiceName, cfg.Healthy, hygieia.Config{
+		Reflection: config.BoolFromEnv("ENABLE_REFLECTION"),
+	})
 
-	logging.Info(fmt.Sprintf("Server listening on %s", port))
-	if err := server.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	go func() {
+		logging.Info(fmt.Sprintf("Server listening on %s", port))
+		if err := server.Serve(listener); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	logging.System("shutting down, draining in-flight requests")
+	if !hygieia.GracefulStop(server, health, shutdown) {
+		logging.Error("in-flight requests did not finish in time and were cut off")
+	}
+	if err := cfg.Close(); err != nil {
+		logging.Error(err.Error())
 	}
 }
@@ -3,16 +3,17 @@ package epimeleia
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/odysseia-greek/agora/archytas"
 	"github.com/odysseia-greek/agora/aristoteles"
 	"github.com/odysseia-greek/agora/plato/randomizer"
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	v1 "github.com/odysseia-greek/makedonia/perdikkas/gen/go/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -40,6 +41,7 @@ type PartialServiceClient struct {
 }
 type PartialClient struct {
 	partial v1.PerdikkasServiceClient
+	health  grpc_health_v1.HealthClient
 }
 
 func NewPerdikkasClient(address string) (*PartialClient, error) {
@@ -51,24 +53,11 @@ func NewPerdikkasClient(address string) (*PartialClient, error) {
 		return nil, fmt.Errorf("failed to connect to tracing service: %w", err)
 	}
 	client := v1.NewPerdikkasServiceClient(conn)
-	return &PartialClient{partial: client}, nil
+	return &PartialClient{partial: client, health: grpc_health_v1.NewHealthClient(conn)}, nil
 }
 
 func (p *PartialClient) WaitForHealthyState() bool {
-	timeout := 30 * time.Second
-	checkInterval := 1 * time.Second
-	endTime := time.Now().Add(timeout)
-
-	for time.Now().Before(endTime) {
-		response, err := p.Health(context.Background(), &emptypb.Empty{})
-		if err == nil && response.Healthy {
-			return true
-		}
-
-		time.Sleep(checkInterval)
-	}
-
-	return false
+	return hygieia.WaitForServing(p.health, v1.PerdikkasService_ServiceDesc.ServiceName, hygieia.DefaultWaitTimeout)
 }
 
 func (p *PartialClient) Health(ctx context.Context, request *emptypb.Empty) (*koinos.HealthResponse, error) {
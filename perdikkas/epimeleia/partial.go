@@ -2,7 +2,7 @@ package epimeleia
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +10,7 @@ import (
 	"github.com/odysseia-greek/agora/plato/transform"
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
 	"github.com/odysseia-greek/makedonia/filippos/hetairoi"
 	v1 "github.com/odysseia-greek/makedonia/perdikkas/gen/go/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,11 +33,22 @@ func (p *PartialServiceImpl) Health(ctx context.Context, request *emptypb.Empty)
 	}, nil
 }
 
+// Healthy drives the grpc.health.v1 status, the service is of no use without Elasticsearch.
+func (p *PartialServiceImpl) Healthy(ctx context.Context) bool {
+	return p.Elastic.Health().Info().Healthy
+}
+
+// Close ends the trace stream and closes the cache.
+func (p *PartialServiceImpl) Close() error {
+	return errors.Join(p.Streamer.CloseSend(), p.Archytas.Close())
+}
+
 func (p *PartialServiceImpl) Search(ctx context.Context, request *koinos.SearchQuery) (*v1.SearchResponse, error) {
 	baseWord := extractBaseWord(request.Word)
 
-	if request.NumberOfResults == 0 {
-		request.NumberOfResults = 5
+	page, err := hetairoi.PageFromQuery(request)
+	if err != nil {
+		return nil, hamartia.InvalidArgument(hamartia.ReasonInvalidPage, err)
 	}
 
 	var query map[string]interface{}
@@ -49,7 +61,7 @@ func (p *PartialServiceImpl) Search(ctx context.Context, request *koinos.SearchQ
 	case koinos.Language_LANG_DUTCH:
 		lang = "dutch"
 	default:
-		return nil, fmt.Errorf("unsupported language: %v", request.Language)
+		return nil, hamartia.UnsupportedLanguage(request.Language)
 	}
 
 	query = map[string]interface{}{
@@ -64,39 +76,29 @@ func (p *PartialServiceImpl) Search(ctx context.Context, request *koinos.SearchQ
 				},
 			},
 		},
-		"size": request.NumberOfResults,
 	}
 
-	elasticResponse, err := p.Elastic.Query().Match(p.Index, query)
+	page.Apply(query)
+
+	raw, err := p.Elastic.Query().MatchRaw(p.Index, query)
 	if err != nil {
-		return nil, fmt.Errorf("error querying elastic: %w", err)
+		return nil, hamartia.Backend(ctx, hamartia.ReasonSearchBackend, fmt.Errorf("error querying elastic: %w", err))
 	}
 
-	hitsTotal := int64(0)
-	if elasticResponse.Hits.Hits != nil {
-		hitsTotal = elasticResponse.Hits.Total.Value
+	elasticResponse, err := hetairoi.DecodeSearchResult(raw)
+	if err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
-	go comedy.DatabaseSpan(query, hitsTotal, elasticResponse.Took, ctx, p.Streamer)
-
-	hits := elasticResponse.Hits.Hits
-
-	results := make([]*koinos.Lemma, 0, len(hits))
-	for _, hit := range hits {
-		source, _ := json.Marshal(hit.Source)
-		var src hetairoi.LemmaSource
-		if err := json.Unmarshal(source, &src); err != nil {
-			b, _ := json.Marshal(hit.Source)
-			if err2 := json.Unmarshal(b, &src); err2 != nil {
-				return nil, fmt.Errorf("decode _source: %w", err2)
-			}
-		}
+	go comedy.DatabaseSpan(query, elasticResponse.Hits.Total.Value, elasticResponse.Took, ctx, p.Streamer)
 
-		results = append(results, hetairoi.LemmaFromSource(src))
+	results, err := page.Lemmas(elasticResponse)
+	if err != nil {
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
 
 	resp := &v1.SearchResponse{
 		Results:  results,
-		PageInfo: &koinos.PageInfo{Page: 1, Size: int32(len(results)), Total: int32(hitsTotal)},
+		PageInfo: page.Info(elasticResponse),
 	}
 	return resp, nil
 }
@@ -6,10 +6,13 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/odysseia-greek/agora/plato/config"
 	"github.com/odysseia-greek/agora/plato/logging"
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	"github.com/odysseia-greek/makedonia/perdikkas/epimeleia"
 	v1 "github.com/odysseia-greek/makedonia/perdikkas/gen/go/v1"
 	"google.golang.org/grpc"
@@ -46,6 +49,11 @@ func main() {
 		log.Fatal("death has found me")
 	}
 
+	shutdown, err := hygieia.ShutdownConfigFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -65,9 +73,26 @@ func main() {
 	)
 
 	v1.RegisterPerdikkasServiceServer(server, cfg)
+	health := hygieia.Register(ctx, server, v1.PerdikkasService_ServiceDesc.ServiceName, cfg.Healthy, hygieia.Config{
+		Reflection: config.BoolFromEnv("ENABLE_REFLECTION"),
+	})
+
+	go func() {
+		logging.Info(fmt.Sprintf("Server listening on %s", port))
+		if err := server.Serve(listener); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
 
-	logging.Info(fmt.Sprintf("Server listening on %s", port))
-	if err := server.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	logging.System("shutting down, draining in-flight requests")
+	if !hygieia.GracefulStop(server, health, shutdown) {
+		logging.Error("in-flight requests did not finish in time and were cut off")
+	}
+	if err := cfg.Close(); err != nil {
+		logging.Error(err.Error())
 	}
 }
@@ -3,7 +3,9 @@ package aigyptos
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/odysseia-greek/agora/plato/config"
@@ -13,6 +15,7 @@ import (
 	"github.com/odysseia-greek/attike/aristophanes/comedy"
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hamartia"
 	v1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -27,6 +30,23 @@ func (e *ExtendedServiceImpl) Health(ctx context.Context, request *emptypb.Empty
 	}, nil
 }
 
+// healthProbeKey is written to and read back from the cache to tell whether it still works.
+const healthProbeKey = "ptolemaios-health-probe"
+
+// Healthy drives the grpc.health.v1 status from the cache every search goes through.
+func (e *ExtendedServiceImpl) Healthy(ctx context.Context) bool {
+	if err := e.Archytas.SetWithTTL(healthProbeKey, time.Now().String(), time.Minute); err != nil {
+		return false
+	}
+	_, err := e.Archytas.Read(healthProbeKey)
+	return err == nil
+}
+
+// Close ends the trace stream and closes the cache.
+func (e *ExtendedServiceImpl) Close() error {
+	return errors.Join(e.Streamer.CloseSend(), e.Archytas.Close())
+}
+
 func (e *ExtendedServiceImpl) Search(ctx context.Context, request *v1.ExtendedSearch) (*v1.ExtendedSearchResponse, error) {
 	requestId := CurrentRequestID(ctx, config.DefaultTracingName, service.HeaderKey)
 
@@ -36,7 +56,7 @@ func (e *ExtendedServiceImpl) Search(ctx context.Context, request *v1.ExtendedSe
 	if cacheItem != nil {
 		err := json.Unmarshal(cacheItem, &analyseResult)
 		if err != nil {
-			return nil, err
+			return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 		}
 
 		logging.Debug(fmt.Sprintf("found in cache: %s number of results: %d", request.Word, len(analyseResult.FoundInText.Texts)))
@@ -58,11 +78,19 @@ func (e *ExtendedServiceImpl) Search(ctx context.Context, request *v1.ExtendedSe
 	r := models.AnalyzeTextRequest{Rootword: request.Word}
 	jsonBody, err := json.Marshal(r)
 	if err != nil {
-		return nil, err
+		return nil, hamartia.Internal(hamartia.ReasonMalformedDocument, err)
 	}
 
 	foundInText, err := e.Client.Herodotos().Analyze(jsonBody, requestId)
 	endTime := time.Since(startTime)
+	if err != nil && foundInText == nil {
+		return nil, hamartia.Backend(ctx, hamartia.ReasonTextBackend, fmt.Errorf("error querying herodotos: %w", err))
+	}
+
+	if foundInText != nil && foundInText.StatusCode == http.StatusNotFound {
+		foundInText.Body.Close()
+		return nil, hamartia.NotFound(hamartia.ReasonNotFound, map[string]string{"word": request.Word}, "no texts found for %s", request.Word)
+	}
 
 	if foundInText != nil {
 		var source models.AnalyzeTextResponse
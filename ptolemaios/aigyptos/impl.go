@@ -3,15 +3,16 @@ package aigyptos
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/odysseia-greek/agora/archytas"
 	"github.com/odysseia-greek/agora/plato/service"
 	arv1 "github.com/odysseia-greek/attike/aristophanes/gen/go/v1"
 	koinos "github.com/odysseia-greek/makedonia/filippos/gen/go/koinos/v1"
+	"github.com/odysseia-greek/makedonia/filippos/hygieia"
 	v1 "github.com/odysseia-greek/makedonia/ptolemaios/gen/go/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -37,6 +38,7 @@ type ExtendedServiceClient struct {
 }
 type ExtendedClient struct {
 	extended v1.PtolemaiosServiceClient
+	health   grpc_health_v1.HealthClient
 }
 
 func NewPtolemaiosClient(address string) (*ExtendedClient, error) {
@@ -48,24 +50,11 @@ func NewPtolemaiosClient(address string) (*ExtendedClient, error) {
 		return nil, fmt.Errorf("failed to connect to tracing service: %w", err)
 	}
 	client := v1.NewPtolemaiosServiceClient(conn)
-	return &ExtendedClient{extended: client}, nil
+	return &ExtendedClient{extended: client, health: grpc_health_v1.NewHealthClient(conn)}, nil
 }
 
 func (e *ExtendedClient) WaitForHealthyState() bool {
-	timeout := 30 * time.Second
-	checkInterval := 1 * time.Second
-	endTime := time.Now().Add(timeout)
-
-	for time.Now().Before(endTime) {
-		response, err := e.Health(context.Background(), &emptypb.Empty{})
-		if err == nil && response.Healthy {
-			return true
-		}
-
-		time.Sleep(checkInterval)
-	}
-
-	return false
+	return hygieia.WaitForServing(e.health, v1.PtolemaiosService_ServiceDesc.ServiceName, hygieia.DefaultWaitTimeout)
 }
 
 func (e *ExtendedClient) Health(ctx context.Context, request *emptypb.Empty) (*koinos.HealthResponse, error) {